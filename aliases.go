@@ -0,0 +1,6 @@
+//go:build !gll_noaliases
+// +build !gll_noaliases
+
+package gll
+
+const aliasFallback = true
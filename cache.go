@@ -0,0 +1,375 @@
+package gll
+
+import "unsafe"
+
+// Cache is a GL that shadows common binding and capability state.
+// Commands that would not change the cached state are dropped, and queries for cached state are answered without calling the GL.
+//
+// The cached state is:
+//   - buffer bindings for every non-indexed target
+//   - texture and sampler bindings for every texture unit, and the active texture unit
+//   - draw and read framebuffer, renderbuffer and vertex array bindings
+//   - the current program and program pipeline
+//   - capabilities set with Enable and Disable
+//
+// State is cached once it is set or queried through the Cache. If the GL state is changed without going through the Cache,
+// eg. by a third-party library, Invalidate must be called before the Cache is used again.
+type Cache struct {
+	GL460
+
+	state map[cacheKey]uint32
+	caps  map[uint32]bool
+}
+
+// cacheKey identifies a piece of binding state by the parameter used to query it, and the texture unit for per-unit state
+type cacheKey struct {
+	pname, unit uint32
+}
+
+// StateCache returns a Cache that forwards commands that change state to gl
+func StateCache(gl GL460) *Cache {
+	c := &Cache{GL460: gl}
+	c.Invalidate()
+	return c
+}
+
+// Invalidate discards all cached state
+func (c *Cache) Invalidate() {
+	c.state = make(map[cacheKey]uint32)
+	c.caps = make(map[uint32]bool)
+}
+
+// bind calls bind unless name is already bound according to the cache
+func (c *Cache) bind(key cacheKey, name uint32, bind func()) {
+	if bound, ok := c.state[key]; ok && bound == name {
+		return
+	}
+	bind()
+	c.state[key] = name
+}
+
+// activeTexture returns the active texture unit as an index, querying it if it is not cached
+func (c *Cache) activeTexture() uint32 {
+	key := cacheKey{ACTIVE_TEXTURE, 0}
+	texture, ok := c.state[key]
+	if !ok {
+		var v int32
+		c.GL460.GetIntegerv(ACTIVE_TEXTURE, &v)
+		texture = uint32(v)
+		c.state[key] = texture
+	}
+	return texture - TEXTURE0
+}
+
+// forget discards cached bindings of the given class, for the given texture units if the state is per-unit
+func (c *Cache) forget(class string, first, count uint32) {
+	for key := range c.state {
+		if cacheClasses[key.pname] == class && key.unit >= first && key.unit-first < count {
+			delete(c.state, key)
+		}
+	}
+}
+
+// deleted updates the cache after objects of the given class are deleted, which unbinds them
+func (c *Cache) deleted(class string, n int32, names *uint32) {
+	if n <= 0 || names == nil {
+		return
+	}
+	for _, name := range *(*[]uint32)(mkslice(uintptr(unsafe.Pointer(names)), int(n))) {
+		if name == 0 {
+			continue
+		}
+		for key, bound := range c.state {
+			if bound == name && cacheClasses[key.pname] == class {
+				c.state[key] = 0
+				if key.pname == VERTEX_ARRAY_BINDING {
+					delete(c.state, cacheKey{ELEMENT_ARRAY_BUFFER_BINDING, 0})
+				}
+			}
+		}
+	}
+}
+
+func (c *Cache) ActiveTexture(texture uint32) {
+	c.bind(cacheKey{ACTIVE_TEXTURE, 0}, texture, func() {
+		c.GL460.ActiveTexture(texture)
+	})
+}
+
+func (c *Cache) BindTexture(target uint32, texture Texture) {
+	pname, ok := textureBindings[target]
+	if !ok {
+		c.GL460.BindTexture(target, texture)
+		return
+	}
+	c.bind(cacheKey{pname, c.activeTexture()}, uint32(texture), func() {
+		c.GL460.BindTexture(target, texture)
+	})
+}
+
+func (c *Cache) BindTextureUnit(unit uint32, texture Texture) {
+	// The target is that of the texture, which is not known here
+	c.GL460.BindTextureUnit(unit, texture)
+	c.forget("texture", unit, 1)
+}
+
+func (c *Cache) BindTextures(first uint32, count int32, textures *Texture) {
+	c.GL460.BindTextures(first, count, textures)
+	c.forget("texture", first, uint32(count))
+}
+
+func (c *Cache) DeleteTextures(n int32, textures *Texture) {
+	c.GL460.DeleteTextures(n, textures)
+	c.deleted("texture", n, (*uint32)(textures))
+}
+
+func (c *Cache) BindSampler(unit uint32, sampler Sampler) {
+	c.bind(cacheKey{SAMPLER_BINDING, unit}, uint32(sampler), func() {
+		c.GL460.BindSampler(unit, sampler)
+	})
+}
+
+func (c *Cache) BindSamplers(first uint32, count int32, samplers *Sampler) {
+	c.GL460.BindSamplers(first, count, samplers)
+	c.forget("sampler", first, uint32(count))
+}
+
+func (c *Cache) DeleteSamplers(count int32, samplers *Sampler) {
+	c.GL460.DeleteSamplers(count, samplers)
+	c.deleted("sampler", count, (*uint32)(samplers))
+}
+
+func (c *Cache) BindBuffer(target uint32, buffer Buffer) {
+	pname, ok := bufferBindings[target]
+	if !ok {
+		c.GL460.BindBuffer(target, buffer)
+		return
+	}
+	c.bind(cacheKey{pname, 0}, uint32(buffer), func() {
+		c.GL460.BindBuffer(target, buffer)
+	})
+}
+
+// Binding to an indexed target also binds to the generic target
+func (c *Cache) BindBufferBase(target uint32, index uint32, buffer Buffer) {
+	c.GL460.BindBufferBase(target, index, buffer)
+	if pname, ok := bufferBindings[target]; ok {
+		c.state[cacheKey{pname, 0}] = uint32(buffer)
+	}
+}
+
+func (c *Cache) BindBufferRange(target uint32, index uint32, buffer Buffer, offset uintptr, size int) {
+	c.GL460.BindBufferRange(target, index, buffer, offset, size)
+	if pname, ok := bufferBindings[target]; ok {
+		c.state[cacheKey{pname, 0}] = uint32(buffer)
+	}
+}
+
+func (c *Cache) DeleteBuffers(n int32, buffers *Buffer) {
+	c.GL460.DeleteBuffers(n, buffers)
+	c.deleted("buffer", n, (*uint32)(buffers))
+}
+
+func (c *Cache) BindFramebuffer(target uint32, framebuffer Framebuffer) {
+	name := uint32(framebuffer)
+	switch target {
+	case DRAW_FRAMEBUFFER:
+		c.bind(cacheKey{DRAW_FRAMEBUFFER_BINDING, 0}, name, func() {
+			c.GL460.BindFramebuffer(target, framebuffer)
+		})
+	case READ_FRAMEBUFFER:
+		c.bind(cacheKey{READ_FRAMEBUFFER_BINDING, 0}, name, func() {
+			c.GL460.BindFramebuffer(target, framebuffer)
+		})
+	default:
+		draw, drawOk := c.state[cacheKey{DRAW_FRAMEBUFFER_BINDING, 0}]
+		read, readOk := c.state[cacheKey{READ_FRAMEBUFFER_BINDING, 0}]
+		if target == FRAMEBUFFER && drawOk && readOk && draw == name && read == name {
+			return
+		}
+		c.GL460.BindFramebuffer(target, framebuffer)
+		if target == FRAMEBUFFER {
+			c.state[cacheKey{DRAW_FRAMEBUFFER_BINDING, 0}] = name
+			c.state[cacheKey{READ_FRAMEBUFFER_BINDING, 0}] = name
+		}
+	}
+}
+
+func (c *Cache) DeleteFramebuffers(n int32, framebuffers *Framebuffer) {
+	c.GL460.DeleteFramebuffers(n, framebuffers)
+	c.deleted("framebuffer", n, (*uint32)(framebuffers))
+}
+
+func (c *Cache) BindRenderbuffer(target uint32, renderbuffer uint32) {
+	if target != RENDERBUFFER {
+		c.GL460.BindRenderbuffer(target, renderbuffer)
+		return
+	}
+	c.bind(cacheKey{RENDERBUFFER_BINDING, 0}, renderbuffer, func() {
+		c.GL460.BindRenderbuffer(target, renderbuffer)
+	})
+}
+
+func (c *Cache) DeleteRenderbuffers(n int32, renderbuffers *uint32) {
+	c.GL460.DeleteRenderbuffers(n, renderbuffers)
+	c.deleted("renderbuffer", n, renderbuffers)
+}
+
+func (c *Cache) BindVertexArray(array VertexArray) {
+	c.bind(cacheKey{VERTEX_ARRAY_BINDING, 0}, uint32(array), func() {
+		c.GL460.BindVertexArray(array)
+		// The element array buffer binding is part of the vertex array state
+		delete(c.state, cacheKey{ELEMENT_ARRAY_BUFFER_BINDING, 0})
+	})
+}
+
+// Setting the element array buffer of the bound vertex array changes the ELEMENT_ARRAY_BUFFER binding
+func (c *Cache) VertexArrayElementBuffer(vaobj VertexArray, buffer Buffer) {
+	c.GL460.VertexArrayElementBuffer(vaobj, buffer)
+	key := cacheKey{ELEMENT_ARRAY_BUFFER_BINDING, 0}
+	if bound, ok := c.state[cacheKey{VERTEX_ARRAY_BINDING, 0}]; !ok {
+		delete(c.state, key)
+	} else if bound == uint32(vaobj) {
+		c.state[key] = uint32(buffer)
+	}
+}
+
+func (c *Cache) DeleteVertexArrays(n int32, arrays *VertexArray) {
+	c.GL460.DeleteVertexArrays(n, arrays)
+	c.deleted("vertex array", n, (*uint32)(arrays))
+}
+
+func (c *Cache) UseProgram(program Program) {
+	c.bind(cacheKey{CURRENT_PROGRAM, 0}, uint32(program), func() {
+		c.GL460.UseProgram(program)
+	})
+}
+
+func (c *Cache) BindProgramPipeline(pipeline uint32) {
+	c.bind(cacheKey{PROGRAM_PIPELINE_BINDING, 0}, pipeline, func() {
+		c.GL460.BindProgramPipeline(pipeline)
+	})
+}
+
+func (c *Cache) DeleteProgramPipelines(n int32, pipelines *uint32) {
+	c.GL460.DeleteProgramPipelines(n, pipelines)
+	c.deleted("program pipeline", n, pipelines)
+}
+
+func (c *Cache) Enable(cap uint32) {
+	if enabled, ok := c.caps[cap]; ok && enabled {
+		return
+	}
+	c.GL460.Enable(cap)
+	c.caps[cap] = true
+}
+
+func (c *Cache) Disable(cap uint32) {
+	if enabled, ok := c.caps[cap]; ok && !enabled {
+		return
+	}
+	c.GL460.Disable(cap)
+	c.caps[cap] = false
+}
+
+// Enabling or disabling a single index of a capability changes the result of IsEnabled only for index 0,
+// so the capability is no longer cached
+func (c *Cache) Enablei(target uint32, index uint32) {
+	c.GL460.Enablei(target, index)
+	delete(c.caps, target)
+}
+
+func (c *Cache) Disablei(target uint32, index uint32) {
+	c.GL460.Disablei(target, index)
+	delete(c.caps, target)
+}
+
+func (c *Cache) IsEnabled(cap uint32) bool {
+	enabled, ok := c.caps[cap]
+	if !ok {
+		enabled = c.GL460.IsEnabled(cap)
+		c.caps[cap] = enabled
+	}
+	return enabled
+}
+
+func (c *Cache) GetIntegerv(pname uint32, data *int32) {
+	class, ok := cacheClasses[pname]
+	if !ok {
+		c.GL460.GetIntegerv(pname, data)
+		return
+	}
+	key := cacheKey{pname, 0}
+	if class == "texture" || class == "sampler" {
+		key.unit = c.activeTexture()
+	}
+	if v, ok := c.state[key]; ok {
+		*data = int32(v)
+		return
+	}
+	c.GL460.GetIntegerv(pname, data)
+	c.state[key] = uint32(*data)
+}
+
+// PopAttrib and PopClientAttrib may restore any of the cached state
+func (c *Cache) PopAttrib() {
+	c.GL460.PopAttrib()
+	c.Invalidate()
+}
+
+func (c *Cache) PopClientAttrib() {
+	c.GL460.PopClientAttrib()
+	c.Invalidate()
+}
+
+var bufferBindings = map[uint32]uint32{
+	ARRAY_BUFFER:              ARRAY_BUFFER_BINDING,
+	ATOMIC_COUNTER_BUFFER:     ATOMIC_COUNTER_BUFFER_BINDING,
+	COPY_READ_BUFFER:          COPY_READ_BUFFER_BINDING,
+	COPY_WRITE_BUFFER:         COPY_WRITE_BUFFER_BINDING,
+	DISPATCH_INDIRECT_BUFFER:  DISPATCH_INDIRECT_BUFFER_BINDING,
+	DRAW_INDIRECT_BUFFER:      DRAW_INDIRECT_BUFFER_BINDING,
+	ELEMENT_ARRAY_BUFFER:      ELEMENT_ARRAY_BUFFER_BINDING,
+	PARAMETER_BUFFER:          PARAMETER_BUFFER_BINDING,
+	PIXEL_PACK_BUFFER:         PIXEL_PACK_BUFFER_BINDING,
+	PIXEL_UNPACK_BUFFER:       PIXEL_UNPACK_BUFFER_BINDING,
+	QUERY_BUFFER:              QUERY_BUFFER_BINDING,
+	SHADER_STORAGE_BUFFER:     SHADER_STORAGE_BUFFER_BINDING,
+	TRANSFORM_FEEDBACK_BUFFER: TRANSFORM_FEEDBACK_BUFFER_BINDING,
+	UNIFORM_BUFFER:            UNIFORM_BUFFER_BINDING,
+}
+
+var textureBindings = map[uint32]uint32{
+	TEXTURE_1D:                   TEXTURE_BINDING_1D,
+	TEXTURE_2D:                   TEXTURE_BINDING_2D,
+	TEXTURE_3D:                   TEXTURE_BINDING_3D,
+	TEXTURE_1D_ARRAY:             TEXTURE_BINDING_1D_ARRAY,
+	TEXTURE_2D_ARRAY:             TEXTURE_BINDING_2D_ARRAY,
+	TEXTURE_RECTANGLE:            TEXTURE_BINDING_RECTANGLE,
+	TEXTURE_CUBE_MAP:             TEXTURE_BINDING_CUBE_MAP,
+	TEXTURE_CUBE_MAP_ARRAY:       TEXTURE_BINDING_CUBE_MAP_ARRAY,
+	TEXTURE_BUFFER:               TEXTURE_BINDING_BUFFER,
+	TEXTURE_2D_MULTISAMPLE:       TEXTURE_BINDING_2D_MULTISAMPLE,
+	TEXTURE_2D_MULTISAMPLE_ARRAY: TEXTURE_BINDING_2D_MULTISAMPLE_ARRAY,
+}
+
+// cacheClasses maps the query parameter of each piece of cached binding state to the class of object it binds
+var cacheClasses = func() map[uint32]string {
+	classes := map[uint32]string{
+		ACTIVE_TEXTURE:           "",
+		SAMPLER_BINDING:          "sampler",
+		DRAW_FRAMEBUFFER_BINDING: "framebuffer",
+		READ_FRAMEBUFFER_BINDING: "framebuffer",
+		RENDERBUFFER_BINDING:     "renderbuffer",
+		VERTEX_ARRAY_BINDING:     "vertex array",
+		CURRENT_PROGRAM:          "program",
+		PROGRAM_PIPELINE_BINDING: "program pipeline",
+	}
+	for _, pname := range bufferBindings {
+		classes[pname] = "buffer"
+	}
+	for _, pname := range textureBindings {
+		classes[pname] = "texture"
+	}
+	return classes
+}()
@@ -0,0 +1,97 @@
+package gll
+
+import (
+	"testing"
+
+	"github.com/vktec/gll/internal/headless"
+)
+
+func TestStateCache(t *testing.T) {
+	ctx, err := headless.New(4, 5)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ctx.Destroy()
+
+	stats := Stats(New460(ctx.GetProcAddress))
+	gl := StateCache(stats)
+	calls := func() map[CommandID]int {
+		calls := make(map[CommandID]int)
+		for _, cs := range stats.Snapshot().Total {
+			calls[cs.Command] = cs.Calls
+		}
+		stats.Reset()
+		return calls
+	}
+
+	var tex [2]Texture
+	gl.GenTextures(2, &tex[0])
+	gl.ActiveTexture(TEXTURE1)
+	gl.BindTexture(TEXTURE_2D, tex[0])
+	gl.BindTexture(TEXTURE_2D, tex[0])
+	gl.ActiveTexture(TEXTURE0)
+	gl.BindTexture(TEXTURE_2D, tex[1])
+	gl.ActiveTexture(TEXTURE1)
+	gl.BindTexture(TEXTURE_2D, tex[0])
+	gl.Enable(DEPTH_TEST)
+	gl.Enable(DEPTH_TEST)
+	if !gl.IsEnabled(DEPTH_TEST) {
+		t.Error("Depth test not enabled")
+	}
+	var binding int32
+	gl.GetIntegerv(TEXTURE_BINDING_2D, &binding)
+	if Texture(binding) != tex[0] {
+		t.Errorf("Expected texture %d to be bound, got %d", tex[0], binding)
+	}
+	c := calls()
+	if c[CmdBindTexture] != 2 || c[CmdActiveTexture] != 3 || c[CmdEnable] != 1 || c[CmdIsEnabled] != 0 || c[CmdGetIntegerv] != 0 {
+		t.Errorf("Unexpected calls: %v", c)
+	}
+
+	// Deleting a bound texture unbinds it
+	gl.DeleteTextures(1, &tex[0])
+	gl.GetIntegerv(TEXTURE_BINDING_2D, &binding)
+	if binding != 0 {
+		t.Errorf("Expected no texture to be bound, got %d", binding)
+	}
+
+	// State changed behind the cache's back
+	stats.BindTexture(TEXTURE_2D, tex[1])
+	stats.Disable(DEPTH_TEST)
+	gl.Invalidate()
+	calls()
+	gl.GetIntegerv(TEXTURE_BINDING_2D, &binding)
+	if Texture(binding) != tex[1] {
+		t.Errorf("Expected texture %d to be bound, got %d", tex[1], binding)
+	}
+	if gl.IsEnabled(DEPTH_TEST) {
+		t.Error("Depth test enabled")
+	}
+	gl.BindTexture(TEXTURE_2D, tex[1])
+	c = calls()
+	if c[CmdBindTexture] != 0 || c[CmdIsEnabled] != 1 || c[CmdGetIntegerv] != 2 {
+		t.Errorf("Unexpected calls: %v", c)
+	}
+
+	// The element array buffer binding belongs to the bound vertex array
+	var vao VertexArray
+	gl.CreateVertexArrays(1, &vao)
+	gl.BindVertexArray(vao)
+	var buf Buffer
+	gl.CreateBuffers(1, &buf)
+	gl.BindBuffer(ELEMENT_ARRAY_BUFFER, buf)
+	gl.VertexArrayElementBuffer(vao, 0)
+	gl.GetIntegerv(ELEMENT_ARRAY_BUFFER_BINDING, &binding)
+	if binding != 0 {
+		t.Errorf("Expected no element array buffer to be bound, got %d", binding)
+	}
+	gl.BindBuffer(ELEMENT_ARRAY_BUFFER, buf)
+	gl.GetIntegerv(ELEMENT_ARRAY_BUFFER_BINDING, &binding)
+	if Buffer(binding) != buf {
+		t.Errorf("Expected buffer %d to be bound, got %d", buf, binding)
+	}
+	c = calls()
+	if c[CmdBindBuffer] != 2 || c[CmdGetIntegerv] != 0 {
+		t.Errorf("Unexpected calls: %v", c)
+	}
+}
@@ -0,0 +1,664 @@
+package gll
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// Captured traces have the following format. All integers are little endian.
+//
+// A trace starts with the magic string "GLLTRACE" and a uint32 format version, followed by a sequence of records.
+// Each record starts with a record type byte:
+//   - recDefine: a uvarint index and a string command name. Assigns the index to the command.
+//   - recCall: a uvarint command index, the command's arguments, then its return value if it has one.
+//   - recFrame: marks the end of a frame.
+//
+// Strings are a uvarint length followed by that many bytes.
+// Scalars are encoded with the size of their Go type; int, uint and uintptr are 8 bytes.
+// Function arguments, ie. debug callbacks, are omitted.
+// Pointers and GLsync values start with a tag byte:
+//   - ptrNil: a null pointer.
+//   - ptrAddr: a uint64 address. The memory it points to was not captured, eg. because it is an offset into a buffer object.
+//   - ptrMem: a uint64 address followed by a string containing the memory it points to.
+//   - ptrStrs: a uvarint count followed by that many strings, for arrays of strings such as shader sources.
+
+const traceMagic = "GLLTRACE"
+
+// TraceVersion is the version of the capture format written by Capture
+const TraceVersion = 1
+
+const (
+	recDefine = iota
+	recCall
+	recFrame
+)
+
+const (
+	ptrNil = iota
+	ptrAddr
+	ptrMem
+	ptrStrs
+)
+
+var errTraceFormat = errors.New("Invalid trace file")
+
+// Capturer is a GL that records every command it executes, along with the client memory referenced by its arguments.
+// The memory referenced by a pointer is captured if its size can be determined from the registry,
+// which includes most buffer, string and pixel data.
+// Writes made by the application through mapped buffers are not captured.
+type Capturer struct {
+	GL460
+
+	w    *bufio.Writer
+	cmds map[CommandID]uint64
+	args []Arg
+	mem  *ArgMemory
+}
+
+var _ GL460 = (*Capturer)(nil)
+
+// Capture returns a Capturer that forwards every command to gl and writes it to w
+func Capture(gl GL460, w io.Writer) *Capturer {
+	c := &Capturer{
+		w:    bufio.NewWriter(w),
+		cmds: make(map[CommandID]uint64),
+		mem:  NewArgMemory(),
+	}
+	c.GL460 = Hooked(gl, (*captureHooks)(c))
+	c.w.WriteString(traceMagic)
+	c.writeUint(TraceVersion, 4)
+	return c
+}
+
+// Frame marks the end of a frame in the trace
+func (c *Capturer) Frame() {
+	c.w.WriteByte(recFrame)
+}
+
+// FlushTrace writes any buffered data to the underlying writer, and returns the first error encountered while writing the trace.
+// It does not call glFlush; Flush is the GL command.
+func (c *Capturer) FlushTrace() error {
+	return c.w.Flush()
+}
+
+type captureHooks Capturer
+
+func (h *captureHooks) Before(cmd CommandID, args []Arg) {
+	h.args = args
+}
+
+// Calls are written after the command so output arrays can be sized and captured
+func (h *captureHooks) After(cmd CommandID, ret Arg) {
+	c := (*Capturer)(h)
+	args := c.args
+	c.args = nil
+	c.mem.Update(cmd, args)
+
+	idx, ok := c.cmds[cmd]
+	if !ok {
+		idx = uint64(len(c.cmds))
+		c.cmds[cmd] = idx
+		c.w.WriteByte(recDefine)
+		c.writeUvarint(idx)
+		c.writeString(cmd.String())
+	}
+	c.w.WriteByte(recCall)
+	c.writeUvarint(idx)
+
+	info := cmd.Info()
+	for i, arg := range args {
+		c.writeArg(cmd, args, i, arg)
+	}
+	if info.Return != "" {
+		c.writeArg(cmd, args, -1, ret)
+	}
+}
+
+// writeArg writes argument i, or the return value if i is -1
+func (c *Capturer) writeArg(cmd CommandID, args []Arg, i int, arg Arg) {
+	if sync, ok := arg.(GLsync); ok {
+		if sync == nil {
+			c.w.WriteByte(ptrNil)
+		} else {
+			c.w.WriteByte(ptrAddr)
+			c.writeUint(uint64(uintptr(unsafe.Pointer(sync))), 8)
+		}
+		return
+	}
+
+	v := reflect.ValueOf(arg)
+	switch v.Kind() {
+	case reflect.Func:
+	case reflect.Bool:
+		if v.Bool() {
+			c.w.WriteByte(1)
+		} else {
+			c.w.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		c.writeUint(uint64(v.Int()), int(v.Type().Size()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		c.writeUint(v.Uint(), int(v.Type().Size()))
+	case reflect.Float32:
+		c.writeUint(uint64(math.Float32bits(float32(v.Float()))), 4)
+	case reflect.Float64:
+		c.writeUint(math.Float64bits(v.Float()), 8)
+	case reflect.Ptr, reflect.UnsafePointer:
+		c.writePointer(cmd, args, i, v)
+	default:
+		panic("Cannot capture argument of type " + v.Type().String())
+	}
+}
+
+func (c *Capturer) writePointer(cmd CommandID, args []Arg, i int, v reflect.Value) {
+	addr := v.Pointer()
+	if addr == 0 {
+		c.w.WriteByte(ptrNil)
+		return
+	}
+	if i < 0 {
+		c.w.WriteByte(ptrAddr)
+		c.writeUint(uint64(addr), 8)
+		return
+	}
+
+	if v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Ptr {
+		if strs, ok := ArgStrings(cmd, args, i); ok {
+			c.w.WriteByte(ptrStrs)
+			c.writeUvarint(uint64(len(strs)))
+			for _, s := range strs {
+				c.writeString(s)
+			}
+			return
+		}
+	}
+
+	size, ok := c.mem.Size(cmd, args, i)
+	if !ok || size < 0 {
+		c.w.WriteByte(ptrAddr)
+		c.writeUint(uint64(addr), 8)
+		return
+	}
+	c.w.WriteByte(ptrMem)
+	c.writeUint(uint64(addr), 8)
+	c.writeUvarint(uint64(size))
+	c.w.Write(*(*[]byte)(mkslice(addr, size)))
+}
+
+// ArgMemory computes the size of the client memory referenced by pointer arguments, using the registry's len expressions.
+// The size of pixel data depends on the pixel store state, and whether indices are in client memory depends on the element array buffer,
+// so every command must be passed to Update in order.
+type ArgMemory struct {
+	pixel          pixelStore
+	vertexArray    uint32            // The bound vertex array object
+	elementBuffers map[uint32]uint32 // The element array buffer bound to each vertex array object
+}
+
+// NewArgMemory returns an ArgMemory for a context in its initial state
+func NewArgMemory() *ArgMemory {
+	return &ArgMemory{
+		pixel:          pixelStore{pack: pixelLayout{align: 4}, unpack: pixelLayout{align: 4}},
+		elementBuffers: make(map[uint32]uint32),
+	}
+}
+
+// Update tracks the state changed by a command
+func (m *ArgMemory) Update(cmd CommandID, args []Arg) {
+	m.pixel.update(cmd, args)
+	switch cmd {
+	case CmdBindVertexArray, CmdBindVertexArrayAPPLE:
+		m.vertexArray, _ = objectName(args[0])
+	case CmdBindBuffer, CmdBindBufferARB:
+		if args[0].(uint32) == ELEMENT_ARRAY_BUFFER {
+			m.elementBuffers[m.vertexArray], _ = objectName(args[1])
+		}
+	case CmdVertexArrayElementBuffer:
+		vaobj, _ := objectName(args[0])
+		m.elementBuffers[vaobj], _ = objectName(args[1])
+	case CmdDeleteBuffers, CmdDeleteBuffersARB:
+		// Deleted buffers are unbound from the bound vertex array object
+		for _, buffer := range argNames(cmd, args, 1) {
+			if buffer != 0 && m.elementBuffers[m.vertexArray] == buffer {
+				delete(m.elementBuffers, m.vertexArray)
+			}
+		}
+	case CmdDeleteVertexArrays, CmdDeleteVertexArraysAPPLE:
+		for _, array := range argNames(cmd, args, 1) {
+			if array == 0 {
+				continue
+			}
+			delete(m.elementBuffers, array)
+			if m.vertexArray == array {
+				m.vertexArray = 0
+			}
+		}
+	}
+}
+
+// argNames returns the object names in the array passed as argument i
+func argNames(cmd CommandID, args []Arg, i int) []uint32 {
+	arr, ok := objectNames(args[i])
+	if !ok || arr == nil {
+		return nil
+	}
+	n, ok := cmd.Info().ArgLen(args, i)
+	if !ok || n <= 0 {
+		return nil
+	}
+	return *(*[]uint32)(mkslice(uintptr(unsafe.Pointer(arr)), n))
+}
+
+// Size returns the size in bytes of the memory pointed to by argument i.
+// ok is false if the size cannot be determined, or if the pointer is an offset into a bound pixel buffer.
+func (m *ArgMemory) Size(cmd CommandID, args []Arg, i int) (size int, ok bool) {
+	info := cmd.Info()
+	v := reflect.ValueOf(args[i])
+	par := info.Params[i]
+	if par.CString() {
+		return len(GoStr((*uint8)(unsafe.Pointer(v.Pointer())))) + 1, true
+	}
+
+	var n int
+	switch {
+	case par.Len == "COMPSIZE(pname)":
+		n, ok = pnameCount(info, args)
+	case par.Len == "COMPSIZE(count,type)" && strings.TrimSuffix(par.Name, "_") == "indices":
+		return m.indicesSize(info, args)
+	case strings.HasPrefix(par.Len, "COMPSIZE("):
+		return m.pixel.size(info, args, par.Len)
+	default:
+		n, ok = info.ArgLen(args, i)
+	}
+	if !ok {
+		return 0, false
+	}
+	if v.Kind() == reflect.Ptr {
+		n *= int(v.Type().Elem().Size())
+	}
+	return n, true
+}
+
+// indicesSize returns the size of the indices of a draw command, which are in client memory if no element array buffer is bound
+func (m *ArgMemory) indicesSize(info *CommandInfo, args []Arg) (int, bool) {
+	if m.elementBuffers[m.vertexArray] != 0 {
+		return 0, false
+	}
+	count, ok := argByName(info, args, "count")
+	if !ok {
+		return 0, false
+	}
+	type_, ok := argByName(info, args, "type")
+	if !ok {
+		return 0, false
+	}
+	var size int
+	switch type_.(uint32) {
+	case UNSIGNED_BYTE:
+		size = 1
+	case UNSIGNED_SHORT:
+		size = 2
+	case UNSIGNED_INT:
+		size = 4
+	default:
+		return 0, false
+	}
+	n, ok := count.(int32)
+	return int(n) * size, ok
+}
+
+// PointerBinding returns the binding of the buffer that pointer argument i is an offset into when a buffer is bound there,
+// such as PIXEL_UNPACK_BUFFER_BINDING for the pixels of TexImage2D.
+// It returns 0 if the argument always points to client memory.
+func PointerBinding(cmd CommandID, i int) uint32 {
+	info := cmd.Info()
+	par := info.Params[i]
+	if par.Type != "unsafe.Pointer" {
+		return 0
+	}
+	switch {
+	case par.Name == "indirect":
+		return DRAW_INDIRECT_BUFFER_BINDING
+	case par.Name == "indices":
+		return ELEMENT_ARRAY_BUFFER_BINDING
+	case par.Name == "pointer" && (strings.Contains(info.Name, "Pointer") || strings.HasPrefix(info.Name, "glInterleavedArrays")):
+		return ARRAY_BUFFER_BINDING
+	case strings.HasPrefix(info.Name, "glClear"):
+		// Clear values are always read from client memory
+		return 0
+	}
+	if _, ok := argIndex(info, "format"); ok || strings.Contains(info.Name, "Compressed") {
+		if packCommand(info) {
+			return PIXEL_PACK_BUFFER_BINDING
+		}
+		return PIXEL_UNPACK_BUFFER_BINDING
+	}
+	return 0
+}
+
+// ArgStrings reads the array of strings passed as argument i, using the lengths in a "length" parameter if there is one
+func ArgStrings(cmd CommandID, args []Arg, i int) ([]string, bool) {
+	info := cmd.Info()
+	strp, ok := args[i].(**uint8)
+	if !ok {
+		return nil, false
+	}
+	n, ok := info.ArgLen(args, i)
+	if !ok || n < 0 {
+		return nil, false
+	}
+	var lengths *int32
+	for j, par := range info.Params {
+		if par.Name == "length" {
+			lengths, _ = args[j].(*int32)
+		}
+	}
+
+	strS := *(*[]*uint8)(mkslice(uintptr(unsafe.Pointer(strp)), n))
+	strs := make([]string, n)
+	for j, str := range strS {
+		if lengths != nil {
+			if l := (*(*[]int32)(mkslice(uintptr(unsafe.Pointer(lengths)), n)))[j]; l >= 0 {
+				strs[j] = string(*(*[]byte)(mkslice(uintptr(unsafe.Pointer(str)), int(l))))
+				continue
+			}
+		}
+		strs[j] = GoStr(str)
+	}
+	return strs, true
+}
+
+func (c *Capturer) writeUint(v uint64, size int) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	c.w.Write(b[:size])
+}
+func (c *Capturer) writeUvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	c.w.Write(b[:binary.PutUvarint(b[:], v)])
+}
+func (c *Capturer) writeString(s string) {
+	c.writeUvarint(uint64(len(s)))
+	c.w.WriteString(s)
+}
+
+// pixelStore tracks the state needed to compute the size of client pixel data
+type pixelStore struct {
+	pack, unpack             pixelLayout
+	packBuffer, unpackBuffer bool
+}
+
+// pixelLayout holds the pixel store parameters that describe the layout of pixel data in client memory
+type pixelLayout struct {
+	align, rowLength, imageHeight    int
+	skipPixels, skipRows, skipImages int
+}
+
+func (ps *pixelStore) update(cmd CommandID, args []Arg) {
+	switch cmd {
+	case CmdBindBuffer, CmdBindBufferARB:
+		buffer, _ := objectName(args[1])
+		switch args[0].(uint32) {
+		case PIXEL_PACK_BUFFER:
+			ps.packBuffer = buffer != 0
+		case PIXEL_UNPACK_BUFFER:
+			ps.unpackBuffer = buffer != 0
+		}
+	case CmdPixelStorei:
+		ps.set(args[0].(uint32), int(args[1].(int32)))
+	case CmdPixelStoref:
+		ps.set(args[0].(uint32), int(math.Round(float64(args[1].(float32)))))
+	}
+}
+
+func (ps *pixelStore) set(pname uint32, v int) {
+	switch pname {
+	case PACK_ALIGNMENT:
+		ps.pack.align = v
+	case PACK_ROW_LENGTH:
+		ps.pack.rowLength = v
+	case PACK_IMAGE_HEIGHT:
+		ps.pack.imageHeight = v
+	case PACK_SKIP_PIXELS:
+		ps.pack.skipPixels = v
+	case PACK_SKIP_ROWS:
+		ps.pack.skipRows = v
+	case PACK_SKIP_IMAGES:
+		ps.pack.skipImages = v
+	case UNPACK_ALIGNMENT:
+		ps.unpack.align = v
+	case UNPACK_ROW_LENGTH:
+		ps.unpack.rowLength = v
+	case UNPACK_IMAGE_HEIGHT:
+		ps.unpack.imageHeight = v
+	case UNPACK_SKIP_PIXELS:
+		ps.unpack.skipPixels = v
+	case UNPACK_SKIP_ROWS:
+		ps.unpack.skipRows = v
+	case UNPACK_SKIP_IMAGES:
+		ps.unpack.skipImages = v
+	}
+}
+
+// size computes the size of pixel data described by a COMPSIZE expression over format, type and dimensions.
+// The size extends to the end of the last pixel, so it includes the pixels skipped by the pixel store parameters.
+func (ps *pixelStore) size(info *CommandInfo, args []Arg, expr string) (int, bool) {
+	layout := ps.unpack
+	if packCommand(info) {
+		if ps.packBuffer {
+			return 0, false
+		}
+		layout = ps.pack
+	} else if ps.unpackBuffer {
+		return 0, false
+	}
+
+	dims := map[string]int{"width": 1, "height": 1, "depth": 1}
+	var format, type_ uint32
+	ndims := 0
+	for _, name := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(expr, "COMPSIZE("), ")"), ",") {
+		v, ok := argByName(info, args, name)
+		if !ok {
+			return 0, false
+		}
+		switch name {
+		case "format":
+			format, ok = v.(uint32)
+		case "type":
+			type_, ok = v.(uint32)
+		case "width", "height", "depth":
+			var n int32
+			n, ok = v.(int32)
+			dims[name] = int(n)
+			ndims++
+		default:
+			return 0, false
+		}
+		if !ok {
+			return 0, false
+		}
+	}
+	bpp := pixelSize(format, type_)
+	if bpp == 0 || layout.align <= 0 || layout.rowLength < 0 || layout.imageHeight < 0 ||
+		layout.skipPixels < 0 || layout.skipRows < 0 || layout.skipImages < 0 {
+		return 0, false
+	}
+	width, height, depth := dims["width"], dims["height"], dims["depth"]
+	if width <= 0 || height <= 0 || depth <= 0 {
+		return 0, true
+	}
+
+	// One dimensional data is a single row, which is only offset by the skipped pixels
+	size := (layout.skipPixels + width) * bpp
+	if ndims < 2 {
+		return size, true
+	}
+	rowLength := width
+	if layout.rowLength > 0 {
+		rowLength = layout.rowLength
+	}
+	stride := (rowLength*bpp + layout.align - 1) / layout.align * layout.align
+	size += (layout.skipRows + height - 1) * stride
+	if ndims < 3 {
+		return size, true
+	}
+	imageHeight := height
+	if layout.imageHeight > 0 {
+		imageHeight = layout.imageHeight
+	}
+	return size + (layout.skipImages+depth-1)*imageHeight*stride, true
+}
+
+// packCommand reports whether a command writes pixel data to client memory, rather than reading it
+func packCommand(info *CommandInfo) bool {
+	return strings.HasPrefix(info.Name, "glGet") || strings.Contains(info.Name, "ReadPixels") || strings.HasPrefix(info.Name, "glReadn")
+}
+
+func argByName(info *CommandInfo, args []Arg, name string) (Arg, bool) {
+	if i, ok := argIndex(info, name); ok {
+		return args[i], true
+	}
+	return nil, false
+}
+
+func argIndex(info *CommandInfo, name string) (int, bool) {
+	for i, par := range info.Params {
+		if par.Name == name || par.Name == name+"_" {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// pnameCount returns the number of values read or written by a command that takes a parameter name.
+// Parameter names that are not in the group of the pname parameter, or that have a variable number of values, are unknown.
+func pnameCount(info *CommandInfo, args []Arg) (int, bool) {
+	i, ok := argIndex(info, "pname")
+	if !ok {
+		return 0, false
+	}
+	pname, ok := args[i].(uint32)
+	if !ok {
+		return 0, false
+	}
+	if n, ok := pnameCounts[pname]; ok {
+		return n, n > 0
+	}
+	return 1, EnumName(info.Params[i].Group, pname) != ""
+}
+
+// pnameCounts holds the parameter names with more than one value, or 0 for names with a variable number of values
+var pnameCounts = map[uint32]int{
+	VIEWPORT:                      4,
+	SCISSOR_BOX:                   4,
+	COLOR_CLEAR_VALUE:             4,
+	COLOR_WRITEMASK:               4,
+	BLEND_COLOR:                   4,
+	ACCUM_CLEAR_VALUE:             4,
+	DEPTH_RANGE:                   2,
+	MAX_VIEWPORT_DIMS:             2,
+	VIEWPORT_BOUNDS_RANGE:         2,
+	POINT_SIZE_RANGE:              2,
+	LINE_WIDTH_RANGE:              2,
+	ALIASED_POINT_SIZE_RANGE:      2,
+	ALIASED_LINE_WIDTH_RANGE:      2,
+	POLYGON_MODE:                  2,
+	CURRENT_COLOR:                 4,
+	CURRENT_SECONDARY_COLOR:       4,
+	CURRENT_NORMAL:                3,
+	CURRENT_TEXTURE_COORDS:        4,
+	CURRENT_RASTER_POSITION:       4,
+	CURRENT_RASTER_COLOR:          4,
+	CURRENT_RASTER_TEXTURE_COORDS: 4,
+	FOG_COLOR:                     4,
+	LIGHT_MODEL_AMBIENT:           4,
+	MODELVIEW_MATRIX:              16,
+	PROJECTION_MATRIX:             16,
+	TEXTURE_MATRIX:                16,
+	COLOR_MATRIX:                  16,
+	TRANSPOSE_MODELVIEW_MATRIX:    16,
+	TRANSPOSE_PROJECTION_MATRIX:   16,
+	TRANSPOSE_TEXTURE_MATRIX:      16,
+	TRANSPOSE_COLOR_MATRIX:        16,
+	MAP1_GRID_DOMAIN:              2,
+	MAP2_GRID_DOMAIN:              4,
+	MAP2_GRID_SEGMENTS:            2,
+
+	TEXTURE_BORDER_COLOR: 4,
+	TEXTURE_SWIZZLE_RGBA: 4,
+	TEXTURE_ENV_COLOR:    4,
+	OBJECT_PLANE:         4,
+	EYE_PLANE:            4,
+
+	AMBIENT:             4,
+	DIFFUSE:             4,
+	SPECULAR:            4,
+	EMISSION:            4,
+	AMBIENT_AND_DIFFUSE: 4,
+	POSITION:            4,
+	SPOT_DIRECTION:      3,
+	COLOR_INDEXES:       3,
+
+	CURRENT_VERTEX_ATTRIB:      4,
+	COMPUTE_WORK_GROUP_SIZE:    3,
+	SAMPLE_POSITION:            2,
+	PATCH_DEFAULT_OUTER_LEVEL:  4,
+	PATCH_DEFAULT_INNER_LEVEL:  2,
+	POINT_DISTANCE_ATTENUATION: 3,
+
+	COMPRESSED_TEXTURE_FORMATS:                          0,
+	PROGRAM_BINARY_FORMATS:                              0,
+	SHADER_BINARY_FORMATS:                               0,
+	COMPATIBLE_SUBROUTINES:                              0,
+	UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES:                0,
+	ATOMIC_COUNTER_BUFFER_ACTIVE_ATOMIC_COUNTER_INDICES: 0,
+}
+
+// pixelSize returns the size in bytes of a single pixel, or 0 if the format or type is unknown
+func pixelSize(format, type_ uint32) int {
+	switch type_ {
+	case UNSIGNED_BYTE_3_3_2, UNSIGNED_BYTE_2_3_3_REV:
+		return 1
+	case UNSIGNED_SHORT_5_6_5, UNSIGNED_SHORT_5_6_5_REV,
+		UNSIGNED_SHORT_4_4_4_4, UNSIGNED_SHORT_4_4_4_4_REV,
+		UNSIGNED_SHORT_5_5_5_1, UNSIGNED_SHORT_1_5_5_5_REV:
+		return 2
+	case UNSIGNED_INT_8_8_8_8, UNSIGNED_INT_8_8_8_8_REV,
+		UNSIGNED_INT_10_10_10_2, UNSIGNED_INT_2_10_10_10_REV,
+		UNSIGNED_INT_24_8, UNSIGNED_INT_10F_11F_11F_REV, UNSIGNED_INT_5_9_9_9_REV:
+		return 4
+	case FLOAT_32_UNSIGNED_INT_24_8_REV:
+		return 8
+	}
+
+	var size int
+	switch type_ {
+	case UNSIGNED_BYTE, BYTE:
+		size = 1
+	case UNSIGNED_SHORT, SHORT, HALF_FLOAT:
+		size = 2
+	case UNSIGNED_INT, INT, FLOAT:
+		size = 4
+	default:
+		return 0
+	}
+
+	switch format {
+	case RED, GREEN, BLUE, ALPHA, LUMINANCE, DEPTH_COMPONENT, STENCIL_INDEX,
+		RED_INTEGER, GREEN_INTEGER, BLUE_INTEGER, ALPHA_INTEGER:
+		return size
+	case RG, RG_INTEGER, LUMINANCE_ALPHA, DEPTH_STENCIL:
+		return 2 * size
+	case RGB, BGR, RGB_INTEGER, BGR_INTEGER:
+		return 3 * size
+	case RGBA, BGRA, RGBA_INTEGER, BGRA_INTEGER:
+		return 4 * size
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,274 @@
+package gll
+
+import (
+	"bytes"
+	"testing"
+	"unsafe"
+
+	"github.com/vktec/gll/internal/headless"
+)
+
+func TestCaptureReplay(t *testing.T) {
+	ctx, err := headless.New(4, 5)
+	if err != nil {
+		t.Skip(err)
+	}
+	buf := &bytes.Buffer{}
+	gl := Capture(New460(ctx.GetProcAddress), buf)
+
+	var buffers [2]Buffer
+	gl.GenBuffers(2, &buffers[0])
+	gl.BindBuffer(ARRAY_BUFFER, buffers[1])
+	data := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	gl.BufferData(ARRAY_BUFFER, len(data), Ptr(data), STATIC_DRAW)
+	gl.Frame()
+
+	var tex Texture
+	gl.GenTextures(1, &tex)
+	gl.BindTexture(TEXTURE_2D, tex)
+	pixels := []byte{1, 2, 3, 4, 5, 6}
+	gl.PixelStorei(UNPACK_ALIGNMENT, 1)
+	gl.TexImage2D(TEXTURE_2D, 0, RGB, 1, 2, 0, RGB, UNSIGNED_BYTE, Ptr(pixels))
+	gl.Frame()
+	if err := gl.FlushTrace(); err != nil {
+		t.Fatal(err)
+	}
+	ctx.Destroy()
+
+	ctx, err = headless.New(4, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Destroy()
+	rgl := New460(ctx.GetProcAddress)
+	// Use up some names so the replayed objects get different names from the captured ones
+	var usedBuffers [5]Buffer
+	var usedTextures [5]Texture
+	rgl.GenBuffers(int32(len(usedBuffers)), &usedBuffers[0])
+	rgl.GenTextures(int32(len(usedTextures)), &usedTextures[0])
+
+	frames := 0
+	r := NewReplayer(rgl)
+	r.Frame = func(frame int) error {
+		frames++
+		return nil
+	}
+	if err := r.Replay(buf); err != nil {
+		t.Fatal(err)
+	}
+	if frames != 2 {
+		t.Errorf("Expected 2 frames, got %d", frames)
+	}
+
+	buffer := Buffer(r.Name("buffer", uint32(buffers[1])))
+	if buffer == buffers[1] {
+		t.Errorf("Buffer name was not remapped")
+	}
+	result := make([]byte, len(data))
+	rgl.GetNamedBufferSubData(buffer, 0, len(result), Ptr(result))
+	if !bytes.Equal(result, data) {
+		t.Errorf("Incorrect buffer contents: expected %v, got %v", data, result)
+	}
+
+	result = make([]byte, 8)
+	rgl.GetTextureImage(Texture(r.Name("texture", uint32(tex))), 0, RGBA, UNSIGNED_BYTE, int32(len(result)), unsafe.Pointer(&result[0]))
+	expected := []byte{1, 2, 3, 255, 4, 5, 6, 255}
+	if !bytes.Equal(result, expected) {
+		t.Errorf("Incorrect texture contents: expected %v, got %v", expected, result)
+	}
+}
+
+// clientArrayGL accepts the commands of TestReplayUncapturedPointer without executing them,
+// as client vertex arrays are invalid in the core profile contexts used for testing
+type clientArrayGL struct {
+	GL460
+}
+
+func (clientArrayGL) GenVertexArrays(n int32, arrays *VertexArray)                          { *arrays = 1 }
+func (clientArrayGL) BindVertexArray(array VertexArray)                                     {}
+func (clientArrayGL) GenBuffers(n int32, buffers *Buffer)                                   { *buffers = 1 }
+func (clientArrayGL) BindBuffer(target uint32, buffer Buffer)                               {}
+func (clientArrayGL) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {}
+func (clientArrayGL) VertexAttribPointer(index uint32, size int32, type_ uint32, normalized bool, stride int32, pointer unsafe.Pointer) {
+}
+
+func TestReplayUncapturedPointer(t *testing.T) {
+	ctx, err := headless.New(4, 5)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ctx.Destroy()
+	buf := &bytes.Buffer{}
+	// The trace is replayed with a context, which stops at the client array before executing it
+	gl := Capture(clientArrayGL{}, buf)
+
+	var vao VertexArray
+	gl.GenVertexArrays(1, &vao)
+	gl.BindVertexArray(vao)
+	var buffer Buffer
+	gl.GenBuffers(1, &buffer)
+	gl.BindBuffer(ARRAY_BUFFER, buffer)
+	gl.BufferData(ARRAY_BUFFER, 64, nil, STATIC_DRAW)
+	// An offset into the bound buffer
+	gl.VertexAttribPointer(0, 4, FLOAT, false, 0, Offset(16))
+	gl.Frame()
+
+	// Client memory, which is not captured
+	gl.BindBuffer(ARRAY_BUFFER, 0)
+	data := make([]float32, 4)
+	gl.VertexAttribPointer(0, 4, FLOAT, false, 0, Ptr(data))
+	gl.Frame()
+	if err := gl.FlushTrace(); err != nil {
+		t.Fatal(err)
+	}
+
+	frames := 0
+	r := NewReplayer(New460(ctx.GetProcAddress))
+	r.Frame = func(frame int) error {
+		frames++
+		return nil
+	}
+	err = r.Replay(buf)
+	expected := "glVertexAttribPointer: pointer points to client memory that was not captured"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+	if frames != 1 {
+		t.Errorf("Expected 1 frame, got %d", frames)
+	}
+}
+
+func TestCapturePixelPackBuffer(t *testing.T) {
+	ctx, err := headless.New(4, 5)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ctx.Destroy()
+	buf := &bytes.Buffer{}
+	gl := Capture(New460(ctx.GetProcAddress), buf)
+
+	var tex Texture
+	gl.GenTextures(1, &tex)
+	gl.BindTexture(TEXTURE_2D, tex)
+	pixel := []byte{0x12, 0x34, 0x56, 0x78}
+	gl.TexImage2D(TEXTURE_2D, 0, RGBA, 1, 1, 0, RGBA, UNSIGNED_BYTE, Ptr(pixel))
+	var fb Framebuffer
+	gl.GenFramebuffers(1, &fb)
+	gl.BindFramebuffer(READ_FRAMEBUFFER, fb)
+	gl.FramebufferTexture2D(READ_FRAMEBUFFER, COLOR_ATTACHMENT0, TEXTURE_2D, tex, 0)
+
+	// Reads into the pack buffer are offsets, so nothing is captured
+	var pack Buffer
+	gl.GenBuffers(1, &pack)
+	gl.BindBuffer(PIXEL_PACK_BUFFER, pack)
+	gl.BufferData(PIXEL_PACK_BUFFER, 8, nil, STREAM_READ)
+	gl.ReadPixels(0, 0, 1, 1, RGBA, UNSIGNED_BYTE, Offset(4))
+
+	// Reads into client memory are captured once the pack buffer is unbound
+	gl.BindBuffer(PIXEL_PACK_BUFFER, 0)
+	result := make([]byte, 4)
+	gl.ReadPixels(0, 0, 1, 1, RGBA, UNSIGNED_BYTE, Ptr(result))
+	if err := gl.FlushTrace(); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(result, pixel) {
+		t.Fatalf("Incorrect texture contents: expected %v, got %v", pixel, result)
+	}
+	if n := bytes.Count(buf.Bytes(), pixel); n != 2 {
+		t.Errorf("Expected the pixel to be captured twice, found it %d times", n)
+	}
+
+	if err := NewReplayer(New460(ctx.GetProcAddress)).Replay(buf); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArgMemorySize(t *testing.T) {
+	m := NewArgMemory()
+	pixels := Ptr(make([]byte, 1))
+	size := func(cmd CommandID, args ...Arg) (int, bool) {
+		return m.Size(cmd, args, len(args)-1)
+	}
+	update := func(cmd CommandID, args ...Arg) {
+		m.Update(cmd, args)
+	}
+
+	// Rows are padded to the alignment of 4 bytes
+	if n, ok := size(CmdTexImage2D, uint32(TEXTURE_2D), int32(0), int32(RGB), int32(3), int32(2), int32(0), uint32(RGB), uint32(UNSIGNED_BYTE), pixels); !ok || n != 21 {
+		t.Errorf("Incorrect size of 3x2 RGB image: %d, %v", n, ok)
+	}
+
+	update(CmdPixelStorei, uint32(UNPACK_ROW_LENGTH), int32(4))
+	update(CmdPixelStorei, uint32(UNPACK_SKIP_PIXELS), int32(1))
+	update(CmdPixelStorei, uint32(UNPACK_SKIP_ROWS), int32(1))
+	update(CmdPixelStoref, uint32(UNPACK_IMAGE_HEIGHT), float32(3))
+	update(CmdPixelStorei, uint32(UNPACK_SKIP_IMAGES), int32(1))
+	if n, ok := size(CmdTexImage1D, uint32(TEXTURE_1D), int32(0), int32(RGBA), int32(2), int32(0), uint32(RGBA), uint32(UNSIGNED_BYTE), pixels); !ok || n != 12 {
+		t.Errorf("Incorrect size of 1D image with skipped pixels: %d, %v", n, ok)
+	}
+	if n, ok := size(CmdTexImage2D, uint32(TEXTURE_2D), int32(0), int32(RGBA), int32(2), int32(2), int32(0), uint32(RGBA), uint32(UNSIGNED_BYTE), pixels); !ok || n != 44 {
+		t.Errorf("Incorrect size of 2D image with row length and skipped rows: %d, %v", n, ok)
+	}
+	if n, ok := size(CmdTexImage3D, uint32(TEXTURE_3D), int32(0), int32(RGBA), int32(2), int32(2), int32(2), int32(0), uint32(RGBA), uint32(UNSIGNED_BYTE), pixels); !ok || n != 140 {
+		t.Errorf("Incorrect size of 3D image with image height and skipped images: %d, %v", n, ok)
+	}
+	// Pack parameters are separate
+	if n, ok := size(CmdReadPixels, int32(0), int32(0), int32(2), int32(2), uint32(RGBA), uint32(UNSIGNED_BYTE), pixels); !ok || n != 16 {
+		t.Errorf("Incorrect size of read pixels: %d, %v", n, ok)
+	}
+
+	indices := func() (int, bool) {
+		return size(CmdDrawElements, uint32(TRIANGLES), int32(6), uint32(UNSIGNED_SHORT), pixels)
+	}
+	if n, ok := indices(); !ok || n != 12 {
+		t.Errorf("Incorrect size of client indices: %d, %v", n, ok)
+	}
+	update(CmdBindVertexArray, VertexArray(1))
+	update(CmdBindBuffer, uint32(ELEMENT_ARRAY_BUFFER), Buffer(2))
+	if _, ok := indices(); ok {
+		t.Error("Indices sized while an element array buffer is bound")
+	}
+	// The element array buffer belongs to the vertex array object
+	update(CmdBindVertexArray, VertexArray(3))
+	if _, ok := indices(); !ok {
+		t.Error("Indices not sized after binding a vertex array object without an element array buffer")
+	}
+	update(CmdBindVertexArray, VertexArray(1))
+	buffer := Buffer(2)
+	update(CmdDeleteBuffers, int32(1), &buffer)
+	if _, ok := indices(); !ok {
+		t.Error("Indices not sized after deleting the element array buffer")
+	}
+}
+
+func TestReplayReleaseClientArrays(t *testing.T) {
+	r := NewReplayer(nil)
+	freed := 0
+	setup := func(cmd CommandID, args ...Arg) {
+		r.frees = append(r.frees, func() { freed++ })
+		r.track(cmd, cmd.Info(), args)
+		r.release(cmd.Info(), args)
+	}
+
+	setup(CmdBindVertexArray, VertexArray(1))
+	if freed != 1 {
+		t.Errorf("Expected the memory of an ordinary command to be freed, %d freed", freed)
+	}
+	setup(CmdVertexAttribPointer, uint32(0), int32(4), uint32(FLOAT), false, int32(0), unsafe.Pointer(nil))
+	setup(CmdVertexAttribPointer, uint32(1), int32(4), uint32(FLOAT), false, int32(0), unsafe.Pointer(nil))
+	if freed != 1 {
+		t.Errorf("Expected client arrays to be retained, %d freed", freed)
+	}
+	setup(CmdVertexAttribIPointer, uint32(0), int32(4), uint32(INT), int32(0), unsafe.Pointer(nil))
+	if freed != 2 {
+		t.Errorf("Expected the replaced array to be freed, %d freed", freed)
+	}
+	array := VertexArray(1)
+	setup(CmdDeleteVertexArrays, int32(1), &array)
+	if freed != 5 {
+		t.Errorf("Expected the arrays of the deleted vertex array object to be freed, %d freed", freed)
+	}
+	if len(r.retain) != 0 {
+		t.Errorf("Expected no retained arrays, got %d", len(r.retain))
+	}
+}
@@ -0,0 +1,6 @@
+//go:build gll_checkerrors
+// +build gll_checkerrors
+
+package gll
+
+const checkErrors = true
@@ -0,0 +1,37 @@
+//go:build gll_checkerrors
+// +build gll_checkerrors
+
+package gll
+
+import (
+	"testing"
+
+	"github.com/vktec/gll/internal/headless"
+)
+
+func TestCheckErrors(t *testing.T) {
+	ctx, err := headless.New(3, 3)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ctx.Destroy()
+	gl := New330(ctx.GetProcAddress)
+
+	var errs []*CommandError
+	defer func(handler func(*CommandError)) { ErrorHandler = handler }(ErrorHandler)
+	ErrorHandler = func(err *CommandError) { errs = append(errs, err) }
+
+	gl.BindBuffer(0xdead, 0)
+	if len(errs) != 1 {
+		t.Fatalf("Expected 1 error, got %d", len(errs))
+	}
+	if got, want := errs[0].Error(), "glBindBuffer(57005, 0): INVALID_ENUM"; got != want {
+		t.Errorf("Incorrect error: got %q, want %q", got, want)
+	}
+
+	errs = nil
+	gl.ClearColor(0, 0, 0, 1)
+	if len(errs) != 0 {
+		t.Errorf("Unexpected errors: %v", errs)
+	}
+}
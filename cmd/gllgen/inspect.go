@@ -0,0 +1,203 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/vktec/gll/gen"
+)
+
+func dump(args []string) {
+	fs := flag.NewFlagSet("gllgen dump", flag.ExitOnError)
+	xmlFiles := fileFlag(fs)
+	format := fs.String("format", "json", "output format; only json is supported")
+	fs.Parse(args)
+	if *format != "json" {
+		log.Fatalf("Unsupported format %q", *format)
+	}
+	reg := loadRegistry(*xmlFiles...)
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "\t")
+	if err := enc.Encode(reg); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func info(args []string) {
+	fs := flag.NewFlagSet("gllgen info", flag.ExitOnError)
+	xmlFiles := fileFlag(fs)
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		log.Fatal("Usage: gllgen info [-file gl.xml] command")
+	}
+	reg := loadAPIs(*xmlFiles...)
+
+	cmd, ok := reg.Command(fs.Arg(0))
+	if !ok {
+		log.Fatalf("No command named %s", fs.Arg(0))
+	}
+	fmt.Println(cmd.Name)
+	fmt.Printf("\tC:  %s\n", cmd.Signature())
+	fmt.Printf("\tGo: %s\n", goSignature(reg, cmd))
+
+	features, exts := reg.Requiring(cmd.Name)
+	var names []string
+	for _, feat := range features {
+		names = append(names, feat.Name+" ("+feat.API+")")
+	}
+	printList("Versions", names)
+	names = nil
+	for _, ext := range exts {
+		names = append(names, ext.Name)
+	}
+	printList("Extensions", names)
+	if cmd.Alias != "" {
+		printList("Alias of", []string{cmd.Alias})
+	}
+	printList("Aliases", reg.Aliases(cmd.Name))
+
+	var groups []string
+	for _, par := range cmd.Params {
+		if par.Group != "" {
+			groups = append(groups, par.Name+": "+par.Group)
+		}
+	}
+	printList("Enum groups", groups)
+}
+
+func printList(title string, items []string) {
+	if len(items) > 0 {
+		fmt.Printf("\t%s: %s\n", title, strings.Join(items, ", "))
+	}
+}
+
+func goSignature(reg *gen.Registry, cmd gen.Command) string {
+	params := make([]string, len(cmd.Params))
+	for i, par := range cmd.Params {
+		params[i] = par.Name + " " + gen.HandleType(goType(reg, par.Type), par.Class)
+	}
+	sig := strings.TrimPrefix(cmd.Name, "gl") + "(" + strings.Join(params, ", ") + ")"
+	if ret := gen.HandleType(goType(reg, cmd.Return), cmd.ReturnClass); ret != "" {
+		sig += " " + ret
+	}
+	return sig
+}
+
+func goType(reg *gen.Registry, ty string) string {
+	t, ok := gen.GoType(reg.Types, ty)
+	if !ok {
+		return "(unsupported " + ty + ")"
+	}
+	return t
+}
+
+func diffVersions(args []string) {
+	fs := flag.NewFlagSet("gllgen diff-versions", flag.ExitOnError)
+	xmlFiles := fileFlag(fs)
+	api := fs.String("api", "gl", "API whose versions to compare, eg. gles2")
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		log.Fatal("Usage: gllgen diff-versions [-file gl.xml] [-api gl] from to")
+	}
+	from, to := parseVersion(fs.Arg(0)), parseVersion(fs.Arg(1))
+	reg := loadAPIs(*xmlFiles...)
+
+	for _, feat := range reg.NewFeatures(*api, from, to) {
+		fmt.Println(feat.Name)
+		for _, cmd := range feat.Commands {
+			fmt.Printf("\t+%s\n", cmd)
+		}
+		for _, rem := range feat.Removed {
+			for _, cmd := range rem.Commands {
+				if rem.Profile != "" {
+					fmt.Printf("\t-%s (%s)\n", cmd, rem.Profile)
+				} else {
+					fmt.Printf("\t-%s\n", cmd)
+				}
+			}
+		}
+	}
+}
+
+// parseVersion parses a version number such as 4.5 into the form used by gen.Feature, eg. 450
+func parseVersion(s string) int {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		log.Fatalf("Invalid version %q", s)
+	}
+	return int(v*100 + 0.5)
+}
+
+func diff(args []string) {
+	fs := flag.NewFlagSet("gllgen diff", flag.ExitOnError)
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		log.Fatal("Usage: gllgen diff old.xml new.xml")
+	}
+	d := gen.Diff(loadRegistry(fs.Arg(0)), loadRegistry(fs.Arg(1)))
+
+	printSection("Added commands", d.AddedCommands)
+	printSection("Removed commands", d.RemovedCommands)
+	if len(d.ChangedCommands) > 0 {
+		fmt.Println("Changed commands:")
+		for _, c := range d.ChangedCommands {
+			fmt.Printf("\t%s\n", c.Name)
+			for _, change := range c.Changes {
+				if change == "signature" {
+					fmt.Printf("\t\t-%s\n\t\t+%s\n", c.Old.Signature(), c.New.Signature())
+				} else {
+					fmt.Printf("\t\t%s\n", change)
+				}
+			}
+		}
+	}
+	if len(d.AddedEnums) > 0 {
+		fmt.Println("Added enums:")
+		for _, enum := range d.AddedEnums {
+			fmt.Printf("\t%s = %s\n", enum.Name, enum.Value)
+		}
+	}
+	printSection("Removed enums", d.RemovedEnums)
+	if len(d.ChangedEnums) > 0 {
+		fmt.Println("Changed enums:")
+		for _, c := range d.ChangedEnums {
+			fmt.Printf("\t%s: %s -> %s\n", c.Name, c.Old, c.New)
+		}
+	}
+	printSection("Added extensions", d.AddedExtensions)
+	printSection("Removed extensions", d.RemovedExtensions)
+}
+
+func printSection(title string, items []string) {
+	if len(items) > 0 {
+		fmt.Println(title + ":")
+		for _, item := range items {
+			fmt.Printf("\t%s\n", item)
+		}
+	}
+}
+
+func check(args []string) {
+	fs := flag.NewFlagSet("gllgen check", flag.ExitOnError)
+	xmlFiles := fileFlag(fs)
+	kind := fs.String("kind", "", "only report problems of this kind, eg. \"dangling reference\"")
+	fs.Parse(args)
+	reg := loadRegistry(*xmlFiles...)
+
+	found := false
+	for _, p := range gen.Check(reg) {
+		if *kind == "" || string(p.Kind) == *kind {
+			fmt.Println(p)
+			found = true
+		}
+	}
+	if found {
+		os.Exit(1)
+	}
+}
@@ -1,57 +1,180 @@
+// Command gllgen generates gll from the Khronos OpenGL registry, and inspects the registry.
+//
+// Usage:
+//
+//	gllgen [-file gl.xml]... [-refpages dir] [-check]
+//	gllgen dump [-file gl.xml] [-format json]
+//	gllgen info [-file gl.xml] command
+//	gllgen diff-versions [-file gl.xml] [-api gl] from to
+//	gllgen diff old.xml new.xml
+//	gllgen check [-file gl.xml]... [-kind kind]
 package main
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"flag"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
+	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/vktec/gll/gen"
 )
 
 const URL = "https://www.khronos.org/registry/OpenGL/xml/gl.xml"
 
+var commands = map[string]func(args []string){
+	"dump":          dump,
+	"info":          info,
+	"diff-versions": diffVersions,
+	"diff":          diff,
+	"check":         check,
+}
+
 func main() {
-	file := flag.String("file", "", "load registry from xml file rather than downloading")
-	flag.Parse()
 	log.SetFlags(0)
-
-	var r io.ReadCloser
-	var err error
-	if *file != "" {
-		r, err = os.Open(*file)
-		if err != nil {
-			log.Fatal(err)
+	if len(os.Args) > 1 {
+		if cmd, ok := commands[os.Args[1]]; ok {
+			cmd(os.Args[2:])
+			return
 		}
-	} else {
-		res, err := http.Get(URL)
+	}
+	generate(os.Args[1:])
+}
+
+func generate(args []string) {
+	fs := flag.NewFlagSet("gllgen", flag.ExitOnError)
+	xmlFiles := fileFlag(fs)
+	refpages := fs.String("refpages", "", "document commands using the reference pages in `dir`, eg. the gl4 directory of a checkout of OpenGL-Refpages")
+	check := fs.Bool("check", false, "check the generated files are identical to what the other flags generate rather than writing them, and exit with status 1 if not")
+	fs.Parse(args)
+	reg, sources := loadSources(gen.Parse, *xmlFiles...)
+
+	opts := gen.Options{}
+	if *refpages != "" {
+		pages, err := gen.LoadRefPages(*refpages)
 		if err != nil {
 			log.Fatal(err)
 		}
-		r = res.Body
+		opts.RefPages = pages
 	}
-	reg, err := gen.Parse(r)
+	g := gen.NewGenerator(opts)
+	g.Register("glfake/gl.go", gen.Fake)
+	files, err := g.Generate(reg)
 	if err != nil {
 		log.Fatal(err)
 	}
-	r.Close()
+	// -refpages changes the docs, so record it to show -check needs the same flag
+	if *refpages != "" {
+		sources += ", with refpages from " + filepath.ToSlash(*refpages)
+	}
+	header := fmt.Sprintf("// Code generated by gllgen %d from %s. DO NOT EDIT.\n\n", gen.GeneratorVersion, sources)
+	files["gl.go"] = append([]byte(goGenerate+header), files["gl.go"]...)
+	files["glfake/gl.go"] = append([]byte(header), files["glfake/gl.go"]...)
 
-	src, err := gen.Generate(reg)
-	if err != nil {
-		log.Fatal(err)
+	outOfDate := false
+	for _, name := range []string{"gl.go", "glfake/gl.go"} {
+		switch {
+		case !*check:
+			if err := ioutil.WriteFile(name, files[name], 0666); err != nil {
+				log.Fatal(err)
+			}
+		case stale(name, files[name]):
+			fmt.Fprintf(os.Stderr, "%s is out of date with %s\n", name, sources)
+			outOfDate = true
+		}
+	}
+	if outOfDate {
+		os.Exit(1)
 	}
+}
 
-	f, err := os.Create("gl.go")
-	if err != nil {
-		log.Fatal(err)
+// fileList is a flag that may be given more than once
+type fileList []string
+
+func (f *fileList) String() string {
+	return strings.Join(*f, ",")
+}
+func (f *fileList) Set(file string) error {
+	*f = append(*f, file)
+	return nil
+}
+
+func fileFlag(fs *flag.FlagSet) *fileList {
+	f := &fileList{}
+	fs.Var(f, "file", "load registry from xml file rather than downloading; if given more than once, the registries are merged")
+	return f
+}
+
+// loadRegistry parses and merges the OpenGL parts of the registries in files, or downloads the Khronos registry if there are none
+func loadRegistry(files ...string) *gen.Registry {
+	reg, _ := loadSources(gen.Parse, files...)
+	return reg
+}
+
+// loadAPIs is loadRegistry, but keeps every API in the registries
+func loadAPIs(files ...string) *gen.Registry {
+	reg, _ := loadSources(gen.ParseAll, files...)
+	return reg
+}
+
+// loadSources parses the registries with parse and merges them like loadRegistry,
+// and also describes the registries loaded, eg. "gl.xml sha256:0123abcd..."
+func loadSources(parse func(io.Reader) (*gen.Registry, error), files ...string) (*gen.Registry, string) {
+	if len(files) == 0 {
+		res, err := http.Get(URL)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer res.Body.Close()
+		data, err := ioutil.ReadAll(res.Body)
+		if err != nil {
+			log.Fatal(err)
+		}
+		reg, err := parse(bytes.NewReader(data))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return reg, source(path.Base(URL), data)
 	}
-	defer f.Close()
-	if _, err := fmt.Fprintln(f, "//go:generate go run ./cmd/gllgen/"); err != nil {
+
+	regs := make([]*gen.Registry, len(files))
+	sources := make([]string, len(files))
+	for i, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+		regs[i], err = parse(bytes.NewReader(data))
+		if err != nil {
+			log.Fatalf("%s: %v", file, err)
+		}
+		sources[i] = source(filepath.Base(file), data)
+	}
+	reg, err := gen.Merge(regs...)
+	if err != nil {
 		log.Fatal(err)
 	}
-	if _, err := f.Write(src); err != nil {
+	return reg, strings.Join(sources, ", ")
+}
+
+func source(name string, data []byte) string {
+	return fmt.Sprintf("%s sha256:%x", name, sha256.Sum256(data))
+}
+
+const goGenerate = "//go:generate go run ./cmd/gllgen/\n"
+
+// stale returns whether the file name does not contain src
+func stale(name string, src []byte) bool {
+	old, err := ioutil.ReadFile(name)
+	if err != nil && !os.IsNotExist(err) {
 		log.Fatal(err)
 	}
+	return !bytes.Equal(old, src)
 }
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"image"
+	"image/png"
+	"log"
+	"os"
+
+	"github.com/vktec/gll"
+	"github.com/vktec/gll/internal/headless"
+)
+
+var errDone = errors.New("done")
+
+func main() {
+	version := flag.String("gl", "4.5", "OpenGL version of the replay context")
+	width := flag.Int("width", 800, "width of the default framebuffer")
+	height := flag.Int("height", 600, "height of the default framebuffer")
+	frames := flag.Int("frames", 0, "stop after this many frames, if non-zero")
+	screenshot := flag.String("screenshot", "", "write the default framebuffer to `prefix`NNNN.png at the end of each frame")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] trace\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	log.SetFlags(0)
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var major, minor int
+	if _, err := fmt.Sscanf(*version, "%d.%d", &major, &minor); err != nil {
+		log.Fatalf("Invalid version %q", *version)
+	}
+	ctx, err := headless.New(major, minor)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer ctx.Destroy()
+	gl := gll.New460(ctx.GetProcAddress)
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	r := gll.NewReplayer(gl)
+	r.DefaultFramebuffer = defaultFramebuffer(gl, int32(*width), int32(*height))
+	r.Frame = func(frame int) error {
+		if *screenshot != "" {
+			name := fmt.Sprintf("%s%04d.png", *screenshot, frame)
+			if err := writeScreenshot(gl, r.DefaultFramebuffer, *width, *height, name); err != nil {
+				return err
+			}
+		}
+		if frame == *frames {
+			return errDone
+		}
+		return nil
+	}
+	if err := r.Replay(bufio.NewReader(f)); err != nil && err != errDone {
+		log.Fatal(err)
+	}
+}
+
+// defaultFramebuffer creates a framebuffer object to stand in for the default framebuffer, which headless contexts do not have
+func defaultFramebuffer(gl gll.GL460, width, height int32) gll.Framebuffer {
+	var fb gll.Framebuffer
+	var rbs [2]uint32
+	gl.CreateFramebuffers(1, &fb)
+	gl.CreateRenderbuffers(2, &rbs[0])
+	gl.NamedRenderbufferStorage(rbs[0], gll.RGBA8, width, height)
+	gl.NamedRenderbufferStorage(rbs[1], gll.DEPTH24_STENCIL8, width, height)
+	gl.NamedFramebufferRenderbuffer(fb, gll.COLOR_ATTACHMENT0, gll.RENDERBUFFER, rbs[0])
+	gl.NamedFramebufferRenderbuffer(fb, gll.DEPTH_STENCIL_ATTACHMENT, gll.RENDERBUFFER, rbs[1])
+	gl.BindFramebuffer(gll.FRAMEBUFFER, fb)
+	return fb
+}
+
+func writeScreenshot(gl gll.GL460, fb gll.Framebuffer, width, height int, name string) error {
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	// Save the state used to read the image, so the screenshot doesn't disturb the replay
+	var read, packBuffer, packAlign int32
+	gl.GetIntegerv(gll.READ_FRAMEBUFFER_BINDING, &read)
+	gl.GetIntegerv(gll.PIXEL_PACK_BUFFER_BINDING, &packBuffer)
+	gl.GetIntegerv(gll.PACK_ALIGNMENT, &packAlign)
+
+	gl.BindFramebuffer(gll.READ_FRAMEBUFFER, fb)
+	gl.BindBuffer(gll.PIXEL_PACK_BUFFER, 0)
+	gl.PixelStorei(gll.PACK_ALIGNMENT, 4)
+	gl.ReadPixels(0, 0, int32(width), int32(height), gll.RGBA, gll.UNSIGNED_BYTE, gll.Ptr(img.Pix))
+
+	gl.BindFramebuffer(gll.READ_FRAMEBUFFER, gll.Framebuffer(read))
+	gl.BindBuffer(gll.PIXEL_PACK_BUFFER, gll.Buffer(packBuffer))
+	gl.PixelStorei(gll.PACK_ALIGNMENT, packAlign)
+
+	// GL images are stored bottom-up
+	for y := 0; y < height/2; y++ {
+		a := img.Pix[y*img.Stride : (y+1)*img.Stride]
+		b := img.Pix[(height-1-y)*img.Stride : (height-y)*img.Stride]
+		for i := range a {
+			a[i], b[i] = b[i], a[i]
+		}
+	}
+
+	f, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return png.Encode(f, img)
+}
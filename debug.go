@@ -6,7 +6,10 @@ void gllCall_glDebugMessageCallback(void *_func, uintptr_t userParam);
 */
 import "C"
 import (
+	"fmt"
+	"reflect"
 	"runtime"
+	"strings"
 	"sync"
 	"unsafe"
 )
@@ -38,6 +41,73 @@ func (gl *lib) callDebugMessageCallback(glDebugMessageCallback unsafe.Pointer, c
 	}
 	gl.debugProc = callback
 	C.gllCall_glDebugMessageCallback(glDebugMessageCallback, C.uintptr_t(gl.debugIdx))
+	if checkErrors {
+		gl.checkError("glDebugMessageCallback", callback)
+	}
+}
+
+// DebugMessage is a message reported through the debug output callback.
+type DebugMessage struct {
+	Source, Type, ID, Severity uint32
+	Message                    string
+
+	// Caller is the Go call site of the GL command that generated the message.
+	// It is only known for messages delivered by DebugMessageCallbackSync.
+	Caller runtime.Frame
+	// Stack contains the program counters of the Go stack, starting at Caller.
+	// Frames belonging to gll and cgo are omitted.
+	Stack []uintptr
+}
+
+func (msg DebugMessage) Error() string {
+	if msg.Caller.File == "" {
+		return msg.Message
+	}
+	return fmt.Sprintf("%s:%d: %s", msg.Caller.File, msg.Caller.Line, msg.Message)
+}
+
+// DebugMessageCallbackSync enables synchronous debug output and installs callback as the debug message callback.
+// Synchronous output makes the GL report each message from within the command that caused it,
+// which allows the Go caller of that command to be recorded in the message.
+func DebugMessageCallbackSync(gl GL100, callback func(msg DebugMessage)) {
+	gl.Enable(DEBUG_OUTPUT)
+	gl.Enable(DEBUG_OUTPUT_SYNCHRONOUS)
+	gl.DebugMessageCallback(func(source, type_, id, severity uint32, message string) {
+		msg := DebugMessage{
+			Source:   source,
+			Type:     type_,
+			ID:       id,
+			Severity: severity,
+			Message:  message,
+		}
+		msg.Caller, msg.Stack = debugCaller()
+		callback(msg)
+	})
+}
+
+var debugPkg = func() string {
+	name := runtime.FuncForPC(reflect.ValueOf(debugGet).Pointer()).Name()
+	slash := strings.LastIndexByte(name, '/')
+	return name[:slash+strings.IndexByte(name[slash+1:], '.')+2]
+}()
+
+// debugCaller finds the first frame on the stack that belongs to neither gll, cgo nor the runtime
+func debugCaller() (caller runtime.Frame, stack []uintptr) {
+	pcs := make([]uintptr, 64)
+	pcs = pcs[:runtime.Callers(2, pcs)]
+	for i, pc := range pcs {
+		fn := runtime.FuncForPC(pc - 1)
+		if fn == nil {
+			continue
+		}
+		name := fn.Name()
+		if strings.HasPrefix(name, debugPkg) || strings.HasPrefix(name, "runtime.") || strings.HasPrefix(name, "_cgo") {
+			continue
+		}
+		caller, _ = runtime.CallersFrames(pcs[i:]).Next()
+		return caller, pcs[i:]
+	}
+	return runtime.Frame{}, nil
 }
 
 func debugGet(idx int) *lib {
@@ -0,0 +1,51 @@
+package gll_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/vktec/gll"
+	"github.com/vktec/gll/internal/headless"
+)
+
+func TestDebugMessageCallbackSync(t *testing.T) {
+	ctx, err := headless.New(4, 5)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ctx.Destroy()
+	gl := gll.New460(ctx.GetProcAddress)
+
+	var msgs []gll.DebugMessage
+	gll.DebugMessageCallbackSync(gl, func(msg gll.DebugMessage) {
+		msgs = append(msgs, msg)
+	})
+	if !gl.IsEnabled(gll.DEBUG_OUTPUT_SYNCHRONOUS) {
+		t.Error("Synchronous debug output not enabled")
+	}
+
+	_, file, line, _ := runtime.Caller(0)
+	gl.DebugMessageInsert(gll.DEBUG_SOURCE_APPLICATION, gll.DEBUG_TYPE_MARKER, 42, gll.DEBUG_SEVERITY_NOTIFICATION, -1, gll.Str("marker\x00"))
+	line++
+
+	if len(msgs) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(msgs))
+	}
+	msg := msgs[0]
+	if msg.Source != gll.DEBUG_SOURCE_APPLICATION || msg.Type != gll.DEBUG_TYPE_MARKER || msg.ID != 42 || msg.Severity != gll.DEBUG_SEVERITY_NOTIFICATION {
+		t.Errorf("Incorrect message: source %#x, type %#x, id %d, severity %#x", msg.Source, msg.Type, msg.ID, msg.Severity)
+	}
+	if msg.Message != "marker" {
+		t.Errorf("Incorrect message text: %q", msg.Message)
+	}
+	if msg.Caller.File != file || msg.Caller.Line != line {
+		t.Errorf("Incorrect caller: got %s:%d, want %s:%d", msg.Caller.File, msg.Caller.Line, file, line)
+	}
+	if len(msg.Stack) == 0 {
+		t.Error("Stack not recorded")
+	}
+	if want := fmt.Sprintf("%s:%d: marker", file, line); msg.Error() != want {
+		t.Errorf("Incorrect error: got %q, want %q", msg.Error(), want)
+	}
+}
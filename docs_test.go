@@ -0,0 +1,37 @@
+package gll
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestDocs(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "gl.go", nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Commands are documented on every version, not only the one that introduced them
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		spec := gen.Specs[0].(*ast.TypeSpec)
+		if spec.Name.Name != "GL460" {
+			continue
+		}
+		for _, method := range spec.Type.(*ast.InterfaceType).Methods.List {
+			if len(method.Names) == 0 || method.Names[0].Name != "BindBuffer" {
+				continue
+			}
+			if doc := method.Doc.Text(); !strings.HasPrefix(doc, "BindBuffer calls glBindBuffer.\n") {
+				t.Errorf("Incorrect doc comment for GL460.BindBuffer: %q", doc)
+			}
+			return
+		}
+	}
+	t.Error("GL460.BindBuffer not found")
+}
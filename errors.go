@@ -0,0 +1,82 @@
+package gll
+
+/*
+#include <stdint.h>
+uint32_t gllCall_glGetError(void *_func);
+*/
+import "C"
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrorHandler is called with every error reported by GetError after a command, when gll is built with the gll_checkerrors tag.
+// The default handler panics.
+var ErrorHandler = func(err *CommandError) {
+	panic(err)
+}
+
+// CommandError describes an error raised by a GL command
+type CommandError struct {
+	Command string
+	Args    []interface{}
+	Code    uint32
+}
+
+func (err *CommandError) Error() string {
+	args := make([]string, len(err.Args))
+	for i, arg := range err.Args {
+		args[i] = fmt.Sprint(arg)
+	}
+	return fmt.Sprintf("%s(%s): %s", err.Command, strings.Join(args, ", "), ErrorName(err.Code))
+}
+
+// ErrorName returns the name of an error code returned by GetError
+func ErrorName(code uint32) string {
+	if name, ok := errorNames[code]; ok {
+		return name
+	}
+	return fmt.Sprintf("%#x", code)
+}
+
+var errorNames = map[uint32]string{
+	NO_ERROR:                      "NO_ERROR",
+	INVALID_ENUM:                  "INVALID_ENUM",
+	INVALID_VALUE:                 "INVALID_VALUE",
+	INVALID_OPERATION:             "INVALID_OPERATION",
+	STACK_OVERFLOW:                "STACK_OVERFLOW",
+	STACK_UNDERFLOW:               "STACK_UNDERFLOW",
+	OUT_OF_MEMORY:                 "OUT_OF_MEMORY",
+	INVALID_FRAMEBUFFER_OPERATION: "INVALID_FRAMEBUFFER_OPERATION",
+	CONTEXT_LOST:                  "CONTEXT_LOST",
+	TABLE_TOO_LARGE:               "TABLE_TOO_LARGE",
+}
+
+type errorState struct {
+	inBegin bool
+}
+
+func (gl *lib) checkError(cmd string, args ...interface{}) {
+	// GetError is not allowed between Begin and End
+	switch cmd {
+	case "glBegin":
+		gl.inBegin = true
+	case "glEnd":
+		gl.inBegin = false
+	}
+	if gl.inBegin {
+		return
+	}
+
+	// GetError keeps returning an error on a lost context, so the loop is bounded
+	for i := 0; i < maxErrors; i++ {
+		code := uint32(C.gllCall_glGetError(gl.glGetError))
+		if code == NO_ERROR {
+			break
+		}
+		ErrorHandler(&CommandError{cmd, args, code})
+	}
+}
+
+// maxErrors is the number of distinct errors GetError can report
+const maxErrors = 10
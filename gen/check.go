@@ -0,0 +1,113 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ProblemKind classifies the problems found by Check
+type ProblemKind string
+
+const (
+	DanglingReference  ProblemKind = "dangling reference" // A feature or extension requires a command or enum that is not defined
+	EnumConflict       ProblemKind = "enum conflict"      // Enums in the same group share a value, or an enum is defined with different values
+	UnsupportedCommand ProblemKind = "unsupported"        // A command uses a type gll does not support, so no binding is generated for it
+	UndefinedGroup     ProblemKind = "undefined group"    // A parameter uses an enum group that no enum belongs to
+)
+
+// Problem is an inconsistency in a registry
+type Problem struct {
+	Kind    ProblemKind
+	Name    string // The command, enum, feature or extension with the problem
+	Message string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s: %s: %s", p.Kind, p.Name, p.Message)
+}
+
+// Check reports inconsistencies in a registry that Parse and Generate tolerate
+func Check(reg *Registry) []Problem {
+	var problems []Problem
+	report := func(kind ProblemKind, name, format string, args ...interface{}) {
+		problems = append(problems, Problem{kind, name, fmt.Sprintf(format, args...)})
+	}
+
+	cmds := make(map[string]bool, len(reg.Commands))
+	for _, cmd := range reg.Commands {
+		cmds[cmd.Name] = true
+	}
+	enums := make(map[string]bool, len(reg.Enums))
+	for _, enum := range reg.Enums {
+		enums[enum.Name] = true
+	}
+	checkRefs := func(name string, cmdRefs, enumRefs []string) {
+		for _, cmd := range cmdRefs {
+			if !cmds[cmd] {
+				report(DanglingReference, name, "command %s is not defined", cmd)
+			}
+		}
+		for _, enum := range enumRefs {
+			if !enums[enum] {
+				report(DanglingReference, name, "enum %s is not defined", enum)
+			}
+		}
+	}
+	for _, feat := range reg.Features {
+		checkRefs(feat.Name, feat.Commands, feat.Enums)
+		for _, rem := range feat.Removed {
+			checkRefs(feat.Name, rem.Commands, nil)
+		}
+	}
+	for _, ext := range reg.Extensions {
+		checkRefs(ext.Name, ext.Commands, ext.Enums)
+	}
+
+	// Enums whose value is specific to an API are only compared with enums for the same API
+	values := enumValues(reg)
+	groups := make(map[string]map[string]Enum)
+	for _, enum := range reg.Enums {
+		if value := values[enumKey(enum)]; value != enum.Value {
+			report(EnumConflict, enum.Name, "defined as both %s and %s", value, enum.Value)
+		}
+		if enum.Type == "" {
+			continue
+		}
+		for _, group := range strings.Split(enum.Type, ",") {
+			if groups[group] == nil {
+				groups[group] = make(map[string]Enum)
+			}
+			other, ok := groups[group][enum.Value]
+			if ok && other.Name != enum.Name && other.Alias != enum.Name && enum.Alias != other.Name {
+				report(EnumConflict, enum.Name, "has the same value as %s in group %s", other.Name, group)
+			} else if !ok {
+				groups[group][enum.Value] = enum
+			}
+		}
+	}
+
+	for _, cmd := range reg.Commands {
+		if ty, ok := baseType(reg.Types, cmd.Return); !ok {
+			report(UnsupportedCommand, cmd.Name, "return type %s is not supported", ty)
+		}
+		for _, par := range cmd.Params {
+			if ty, ok := baseType(reg.Types, par.Type); !ok {
+				report(UnsupportedCommand, cmd.Name, "type %s of parameter %s is not supported", ty, par.Name)
+			}
+			if par.Group != "" && groups[par.Group] == nil {
+				report(UndefinedGroup, cmd.Name, "parameter %s uses undefined group %s", par.Name, par.Group)
+			}
+		}
+	}
+	return problems
+}
+
+// unsupportedType returns the name of the base type of a C type, and whether gll supports it
+func baseType(types map[string]Type, cType string) (name string, supported bool) {
+	name, _ = ptrParse(cType)
+	if name == "void" {
+		return name, true
+	}
+	ty := types[name]
+	return name, ty != InvalidType && ty != UnsupportedType
+}
@@ -0,0 +1,35 @@
+package gen
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	reg := parseTest(t)
+	reg.Enums = append(reg.Enums,
+		Enum{Name: "GL_CLIENT_ALL_ATTRIB_BITS", Value: "0xFFFFFFFE"},
+		Enum{Name: "GL_CLIENT_TEXTURE_BIT", Type: "ClientAttribMask", Value: "0x00000002"},
+	)
+	reg.Commands[1].Params[0].Group = "BufferCount"
+	reg.Commands[3].Params = append(reg.Commands[3].Params, Param{Name: "context", Type: "struct _cl_event *"})
+
+	expected := []string{
+		"dangling reference: GL_VERSION_4_3: command glDispatchCompute is not defined",
+		"dangling reference: GL_VERSION_4_3: command glDispatchComputeIndirect is not defined",
+		"dangling reference: GL_ARB_clear_texture: command glClearTexImage is not defined",
+		"dangling reference: GL_ARB_clear_texture: command glClearTexSubImage is not defined",
+		"dangling reference: GL_ARB_clear_texture: enum GL_CLEAR_TEXTURE is not defined",
+		"enum conflict: GL_CLIENT_ALL_ATTRIB_BITS: defined as both 0xFFFFFFFF and 0xFFFFFFFE",
+		"enum conflict: GL_CLIENT_TEXTURE_BIT: has the same value as GL_CLIENT_VERTEX_ARRAY_BIT in group ClientAttribMask",
+		"undefined group: glDeleteBuffers: parameter n uses undefined group BufferCount",
+		"unsupported: glCreateProgram: type struct _cl_event of parameter context is not supported",
+	}
+	problems := []string{}
+	for _, p := range Check(reg) {
+		problems = append(problems, p.String())
+	}
+	if !reflect.DeepEqual(problems, expected) {
+		t.Errorf("Incorrect problems:\n\t%q\n\t%q", expected, problems)
+	}
+}
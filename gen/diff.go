@@ -0,0 +1,167 @@
+package gen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RegistryDiff is the difference between two revisions of a registry
+type RegistryDiff struct {
+	AddedCommands   []string
+	RemovedCommands []string
+	ChangedCommands []CommandChange
+
+	AddedEnums   []Enum
+	RemovedEnums []string
+	ChangedEnums []EnumChange
+
+	AddedExtensions   []string
+	RemovedExtensions []string
+}
+
+// CommandChange describes how a command differs between two registries
+type CommandChange struct {
+	Name     string
+	Old, New Command
+	Changes  []string // eg. "signature", or "param target: group TextureTarget -> BufferTargetARB"
+}
+
+// EnumChange is an enum whose value differs between two registries
+type EnumChange struct {
+	Name     string
+	Old, New string
+}
+
+// Empty reports whether the registries are the same
+func (d *RegistryDiff) Empty() bool {
+	return len(d.AddedCommands)+len(d.RemovedCommands)+len(d.ChangedCommands)+
+		len(d.AddedEnums)+len(d.RemovedEnums)+len(d.ChangedEnums)+
+		len(d.AddedExtensions)+len(d.RemovedExtensions) == 0
+}
+
+// Signature returns the command's C declaration, without const qualifiers
+func (cmd Command) Signature() string {
+	params := make([]string, len(cmd.Params))
+	for i, par := range cmd.Params {
+		params[i] = cDecl(par.Type, par.Name)
+	}
+	return cDecl(cmd.Return, cmd.Name) + "(" + strings.Join(params, ", ") + ")"
+}
+
+func cDecl(ty, name string) string {
+	if strings.HasSuffix(ty, "*") {
+		return ty + name
+	}
+	return ty + " " + name
+}
+
+// Diff compares two revisions of a registry
+func Diff(old, new *Registry) *RegistryDiff {
+	d := &RegistryDiff{}
+
+	oldCmds := make(map[string]Command, len(old.Commands))
+	for _, cmd := range old.Commands {
+		oldCmds[cmd.Name] = cmd
+	}
+	newCmds := make(map[string]bool, len(new.Commands))
+	for _, cmd := range new.Commands {
+		newCmds[cmd.Name] = true
+		oldCmd, ok := oldCmds[cmd.Name]
+		if !ok {
+			d.AddedCommands = append(d.AddedCommands, cmd.Name)
+		} else if changes := commandChanges(oldCmd, cmd); len(changes) > 0 {
+			d.ChangedCommands = append(d.ChangedCommands, CommandChange{cmd.Name, oldCmd, cmd, changes})
+		}
+	}
+	for _, cmd := range old.Commands {
+		if !newCmds[cmd.Name] {
+			d.RemovedCommands = append(d.RemovedCommands, cmd.Name)
+		}
+	}
+
+	oldEnums := enumValues(old)
+	newEnums := enumValues(new)
+	removed := enumValues(old)
+	for _, enum := range new.Enums {
+		key := enumKey(enum)
+		delete(removed, key)
+		value, ok := newEnums[key]
+		if !ok {
+			continue // Already compared
+		}
+		delete(newEnums, key)
+		if oldValue, ok := oldEnums[key]; !ok {
+			d.AddedEnums = append(d.AddedEnums, enum)
+		} else if oldValue != value {
+			d.ChangedEnums = append(d.ChangedEnums, EnumChange{key, oldValue, value})
+		}
+	}
+	for _, enum := range old.Enums {
+		if _, ok := removed[enumKey(enum)]; ok {
+			d.RemovedEnums = append(d.RemovedEnums, enumKey(enum))
+			delete(removed, enumKey(enum))
+		}
+	}
+
+	oldExts := extensionNames(old)
+	newExts := extensionNames(new)
+	for _, ext := range new.Extensions {
+		if !oldExts[ext.Name] {
+			d.AddedExtensions = append(d.AddedExtensions, ext.Name)
+		}
+	}
+	for _, ext := range old.Extensions {
+		if !newExts[ext.Name] {
+			d.RemovedExtensions = append(d.RemovedExtensions, ext.Name)
+		}
+	}
+	return d
+}
+
+func commandChanges(old, new Command) []string {
+	var changes []string
+	if old.Signature() != new.Signature() {
+		return []string{"signature"}
+	}
+	change := func(what, old, new string) {
+		if old != new {
+			changes = append(changes, fmt.Sprintf("%s: %q -> %q", what, old, new))
+		}
+	}
+	change("return class", old.ReturnClass, new.ReturnClass)
+	change("alias", old.Alias, new.Alias)
+	for i, par := range new.Params {
+		oldPar := old.Params[i]
+		change("param "+par.Name+" group", oldPar.Group, par.Group)
+		change("param "+par.Name+" len", oldPar.Len, par.Len)
+		change("param "+par.Name+" class", oldPar.Class, par.Class)
+	}
+	return changes
+}
+
+// enumValues returns the value of each enum by enumKey, using the first if an enum appears more than once
+func enumValues(reg *Registry) map[string]string {
+	values := make(map[string]string, len(reg.Enums))
+	for _, enum := range reg.Enums {
+		if _, ok := values[enumKey(enum)]; !ok {
+			values[enumKey(enum)] = enum.Value
+		}
+	}
+	return values
+}
+
+// enumKey identifies an enum by its name, and its API if its value is specific to one, eg. "GL_ACTIVE_PROGRAM_EXT (gles2)"
+func enumKey(enum Enum) string {
+	if enum.API == "" {
+		return enum.Name
+	}
+	return enum.Name + " (" + enum.API + ")"
+}
+
+func extensionNames(reg *Registry) map[string]bool {
+	names := make(map[string]bool, len(reg.Extensions))
+	for _, ext := range reg.Extensions {
+		names[ext.Name] = true
+	}
+	return names
+}
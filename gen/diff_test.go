@@ -0,0 +1,47 @@
+package gen
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	old := parseTest(t)
+	new := parseTest(t)
+	if d := Diff(old, new); !d.Empty() {
+		t.Errorf("Expected no differences, got %+v", d)
+	}
+
+	new.Commands[0].Params[0].Type = "GLenum"
+	new.Commands[1].Params[1].Len = "count"
+	new.Commands = append(new.Commands[:3], Command{"glDispatchCompute", nil, "void", "", "", "", ""})
+	new.Enums[0].Value = "0x10"
+	new.Enums = append(new.Enums, Enum{Name: "GL_NEW", Value: "0x20"})
+	new.Extensions = new.Extensions[1:]
+
+	d := Diff(old, new)
+	if !reflect.DeepEqual(d.AddedCommands, []string{"glDispatchCompute"}) {
+		t.Errorf("Incorrect added commands: %v", d.AddedCommands)
+	}
+	if !reflect.DeepEqual(d.RemovedCommands, []string{"glCreateProgram"}) {
+		t.Errorf("Incorrect removed commands: %v", d.RemovedCommands)
+	}
+	if len(d.ChangedCommands) != 2 ||
+		d.ChangedCommands[0].Name != "glClientAttribDefaultEXT" ||
+		!reflect.DeepEqual(d.ChangedCommands[0].Changes, []string{"signature"}) ||
+		!reflect.DeepEqual(d.ChangedCommands[1].Changes, []string{`param buffers len: "n" -> "count"`}) {
+		t.Errorf("Incorrect changed commands: %+v", d.ChangedCommands)
+	}
+	if len(d.AddedEnums) != 1 || d.AddedEnums[0].Name != "GL_NEW" {
+		t.Errorf("Incorrect added enums: %v", d.AddedEnums)
+	}
+	if !reflect.DeepEqual(d.ChangedEnums, []EnumChange{{"GL_CLIENT_PIXEL_STORE_BIT", "0x00000001", "0x10"}}) {
+		t.Errorf("Incorrect changed enums: %v", d.ChangedEnums)
+	}
+	if len(d.RemovedEnums) != 0 || len(d.AddedExtensions) != 0 {
+		t.Errorf("Unexpected removed enums or added extensions: %v %v", d.RemovedEnums, d.AddedExtensions)
+	}
+	if !reflect.DeepEqual(d.RemovedExtensions, []string{"GL_ARB_clear_texture"}) {
+		t.Errorf("Incorrect removed extensions: %v", d.RemovedExtensions)
+	}
+}
@@ -0,0 +1,224 @@
+package gen
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// RefPage is the documentation of a command from the OpenGL reference pages
+type RefPage struct {
+	Summary string            // eg. "render primitives from array data"
+	Params  map[string]string // The description of each parameter, by name
+}
+
+type xRefEntry struct {
+	Names    []string    `xml:"refnamediv>refname"`
+	Purpose  xText       `xml:"refnamediv>refpurpose"`
+	Sections []xRefSect1 `xml:"refsect1"`
+}
+type xRefSect1 struct {
+	Title   xText       `xml:"title"`
+	Entries []xVarEntry `xml:"variablelist>varlistentry"`
+}
+type xVarEntry struct {
+	Terms []xText `xml:"term"`
+	Desc  xText   `xml:"listitem"`
+}
+
+// xText is the text content of an element, with whitespace collapsed
+type xText string
+
+func (t *xText) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	b := strings.Builder{}
+	for depth := 1; depth > 0; {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			b.Write(tok)
+		}
+	}
+	*t = xText(strings.Join(strings.Fields(b.String()), " "))
+	return nil
+}
+
+// LoadRefPages reads the reference pages in dir, such as the gl4 directory of a checkout of
+// https://github.com/KhronosGroup/OpenGL-Refpages, and returns the page of each command they document by name.
+func LoadRefPages(dir string) (map[string]RefPage, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "gl*.xml"))
+	if err != nil {
+		return nil, err
+	}
+	pages := make(map[string]RefPage)
+	for _, file := range files {
+		entry, err := parseRefPage(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		page := RefPage{string(entry.Purpose), make(map[string]string)}
+		for _, sect := range entry.Sections {
+			if sect.Title != "Parameters" {
+				continue
+			}
+			for _, entry := range sect.Entries {
+				for _, term := range entry.Terms {
+					for _, name := range strings.Split(string(term), ",") {
+						page.Params[strings.TrimSpace(name)] = string(entry.Desc)
+					}
+				}
+			}
+		}
+		for _, name := range entry.Names {
+			pages[name] = page
+		}
+	}
+	return pages, nil
+}
+
+func parseRefPage(file string) (*xRefEntry, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	dec := xml.NewDecoder(f)
+	// The pages use MathML and HTML entities defined in external DTDs
+	dec.Strict = false
+	dec.Entity = xml.HTMLEntity
+	entry := &xRefEntry{}
+	return entry, dec.Decode(entry)
+}
+
+// commandDocs generates the doc comment of each command in reg
+func commandDocs(reg *Registry, opts Options) map[string]string {
+	since := make(map[string]int)
+	removed := make(map[string]int)
+	for _, feat := range reg.Features {
+		if feat.API != opts.API {
+			continue
+		}
+		for _, cmd := range feat.Commands {
+			if _, ok := since[cmd]; !ok {
+				since[cmd] = feat.Version
+			}
+		}
+		for _, rem := range feat.Removed {
+			if rem.Profile == "" || rem.Profile == "core" {
+				for _, cmd := range rem.Commands {
+					removed[cmd] = feat.Version
+				}
+			}
+		}
+	}
+	exts := make(map[string][]string)
+	for _, ext := range reg.Extensions {
+		if ext.Name == "" {
+			continue
+		}
+		for _, cmd := range ext.Commands {
+			exts[cmd] = append(exts[cmd], ext.Name)
+		}
+	}
+
+	docs := make(map[string]string, len(reg.Commands))
+	for _, cmd := range reg.Commands {
+		method := strings.TrimPrefix(cmd.Name, "gl")
+		page, hasPage := opts.RefPages[cmd.Name]
+		b := strings.Builder{}
+		if hasPage && page.Summary != "" {
+			fmt.Fprintf(&b, "// %s %s.\n", method, thirdPerson(page.Summary))
+		} else {
+			fmt.Fprintf(&b, "// %s calls %s.\n", method, cmd.Name)
+		}
+		fmt.Fprintf(&b, "//\n//\t%s\n", cmd.Signature())
+
+		var params, groups []string
+		for _, par := range cmd.Params {
+			if desc := page.Params[par.Name]; desc != "" {
+				params = append(params, fmt.Sprintf("//   - %s: %s\n", par.Name, firstSentence(desc)))
+			}
+			if par.Group != "" {
+				groups = append(groups, par.Name+" is "+par.Group)
+			}
+		}
+		if len(params) > 0 {
+			b.WriteString("//\n// Parameters:\n")
+			for _, par := range params {
+				b.WriteString(par)
+			}
+		}
+
+		var notes []string
+		if len(groups) > 0 {
+			notes = append(notes, "Enum groups: "+strings.Join(groups, ", ")+".")
+		}
+		if v, ok := since[cmd.Name]; ok {
+			notes = append(notes, fmt.Sprintf("Introduced in %s %s.", apiName(opts.API), versionString(v)))
+		}
+		if len(exts[cmd.Name]) > 0 {
+			notes = append(notes, "Provided by "+strings.Join(exts[cmd.Name], ", ")+".")
+		}
+		if len(notes) > 0 {
+			b.WriteString("//\n// " + strings.Join(notes, " ") + "\n")
+		}
+		if v, ok := removed[cmd.Name]; ok {
+			fmt.Fprintf(&b, "//\n// Deprecated: removed from the core profile in %s %s.\n", apiName(opts.API), versionString(v))
+		}
+		docs[cmd.Name] = b.String()
+	}
+	return docs
+}
+
+func apiName(api string) string {
+	switch api {
+	case "gl":
+		return "OpenGL"
+	case "gles1", "gles2":
+		return "OpenGL ES"
+	case "glsc2":
+		return "OpenGL SC"
+	}
+	return api
+}
+
+func versionString(v int) string {
+	return fmt.Sprintf("%d.%d", v/100, v%100/10)
+}
+
+// thirdPerson converts a summary from the reference pages, such as "render primitives", to the third person, "renders primitives"
+func thirdPerson(summary string) string {
+	verb := summary
+	rest := ""
+	if i := strings.IndexByte(summary, ' '); i >= 0 {
+		verb, rest = summary[:i], summary[i:]
+	}
+	switch {
+	case verb == "" || !unicode.IsLower(rune(verb[0])):
+		return summary
+	case strings.HasSuffix(verb, "y") && len(verb) > 1 && !strings.ContainsRune("aeiou", rune(verb[len(verb)-2])):
+		verb = verb[:len(verb)-1] + "ies"
+	case strings.HasSuffix(verb, "s") || strings.HasSuffix(verb, "sh") || strings.HasSuffix(verb, "ch") || strings.HasSuffix(verb, "x"):
+		verb += "es"
+	default:
+		verb += "s"
+	}
+	return verb + rest
+}
+
+// firstSentence returns the first sentence of a description
+func firstSentence(desc string) string {
+	if i := strings.Index(desc, ". "); i >= 0 {
+		return desc[:i+1]
+	}
+	return desc
+}
@@ -0,0 +1,74 @@
+package gen
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestDocs(t *testing.T) {
+	reg := parseTest(t)
+	pages, err := LoadRefPages("testdata/refpages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs := commandDocs(reg, Options{API: "gl", RefPages: pages})
+
+	cases := []struct {
+		cmd      string
+		expected []string
+	}{
+		{"glDeleteBuffers", []string{
+			"// DeleteBuffers deletes named buffer objects.\n",
+			"//\tvoid glDeleteBuffers(GLsizei n, GLuint *buffers)\n",
+			"//   - n: Specifies the number of buffer objects to be deleted.\n",
+			"//   - buffers: Specifies an array of buffer objects to be deleted.\n",
+		}},
+		{"glClientAttribDefaultEXT", []string{
+			"// ClientAttribDefaultEXT calls glClientAttribDefaultEXT.\n",
+			"// Enum groups: mask is ClientAttribMask. Provided by GL_EXT_direct_state_access.\n",
+			"// Deprecated: removed from the core profile in OpenGL 3.2.\n",
+		}},
+		{"glCreateProgram", []string{
+			"// Introduced in OpenGL 3.2.\n",
+		}},
+	}
+	for _, c := range cases {
+		doc := docs[c.cmd]
+		for _, line := range c.expected {
+			if !strings.Contains(doc, line) {
+				t.Errorf("Doc comment of %s does not contain %q:\n%s", c.cmd, line, doc)
+			}
+		}
+	}
+	if strings.Contains(docs["glDeleteBuffers"], "Introduced") {
+		t.Errorf("Doc comment of glDeleteBuffers includes a version from another API:\n%s", docs["glDeleteBuffers"])
+	}
+}
+
+func TestDocsEveryVersion(t *testing.T) {
+	src, err := Generate(parseTest(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Commands are documented on the interface of every version that has them, and commands only in extensions on Extensions
+	cases := []struct {
+		cmd, method string
+		ifaces      []string
+	}{
+		{"glCreateProgram", "CreateProgram() Program", []string{"GL320", "GL430"}},
+		{"glClientAttribDefaultEXT", "ClientAttribDefaultEXT(mask uint32)", []string{"Extensions"}},
+	}
+	for _, c := range cases {
+		doc := "// " + strings.TrimPrefix(c.cmd, "gl") + " calls " + c.cmd + ".\n"
+		documented := regexp.MustCompile(regexp.QuoteMeta(doc) + `(?:\t//.*\n)*\t` + regexp.QuoteMeta(c.method) + "\n")
+		var ifaces []string
+		for _, loc := range documented.FindAllIndex(src, -1) {
+			iface := strings.LastIndex(string(src[:loc[0]]), "\ntype ")
+			ifaces = append(ifaces, strings.Fields(string(src[iface+1:]))[1])
+		}
+		if strings.Join(ifaces, " ") != strings.Join(c.ifaces, " ") {
+			t.Errorf("Expected %s to be documented on %v, got %v", c.cmd, c.ifaces, ifaces)
+		}
+	}
+}
@@ -3,30 +3,41 @@ package gen
 import (
 	"bytes"
 	"fmt"
-	"go/format"
 	"sort"
+	"strconv"
 	"strings"
 )
 
+// Generate generates gll's bindings for every version and extension of OpenGL in reg
 func Generate(reg *Registry) (src []byte, err error) {
+	return NewGenerator(Options{}).generate("gl.go", reg)
+}
+
+// Bindings is the Emitter for gll's bindings, the gl.go file
+func Bindings(reg *Registry, opts Options) (src []byte, err error) {
 	buf := bytes.Buffer{}
-	buf.WriteString("package gll\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", opts.Package)
 
 	buf.WriteString("/*\n")
-	buf.WriteString("#cgo linux pkg-config: gl\n")
-	buf.WriteString("#cgo windows LDFLAGS: -lopengl32\n")
+	for _, directive := range opts.Cgo {
+		buf.WriteString(directive)
+		buf.WriteByte('\n')
+	}
 	genC(&buf, reg)
 	buf.WriteString("*/\n")
 
 	buf.WriteString("import \"C\"\n\n")
 	buf.WriteString("import \"unsafe\"\n\n")
-	cmdSigs := genLib(&buf, reg)
-	genVersions(&buf, cmdSigs, reg)
-	genExtensions(&buf, cmdSigs, reg)
+	cmds := genLib(&buf, reg, commandDocs(reg, opts))
+	genVersions(&buf, cmds, reg)
+	genExtensions(&buf, cmds, reg)
+	genAliases(&buf, cmds, reg)
+	genHooks(&buf, cmds, reg)
 	genTypes(&buf)
 	genEnums(&buf, reg)
+	genEnumGroups(&buf, reg)
 
-	return format.Source(buf.Bytes())
+	return buf.Bytes(), nil
 }
 
 func genC(buf *bytes.Buffer, reg *Registry) {
@@ -40,6 +51,11 @@ typedef void *GLhandleARB;
 typedef unsigned int GLhandleARB;
 #endif
 typedef struct __GLsync *GLsync;
+typedef void *GLeglImageOES;
+typedef void *GLeglClientBufferEXT;
+struct _cl_context;
+struct _cl_event;
+typedef void (*GLVULKANPROCNV)(void);
 
 `)
 
@@ -82,25 +98,60 @@ commands:
 	}
 }
 
-func genLib(buf *bytes.Buffer, reg *Registry) (cmdSigs map[string]string) {
-	cmdSigs = make(map[string]string, len(reg.Commands))
+// libCommand describes the Go method generated for a command
+type libCommand struct {
+	Params      []libParam
+	Return      string // Empty for void commands
+	ReturnClass string
+	ReturnGroup string
+	Doc         string // The doc comment of the command's interface method
+}
+type libParam struct {
+	Name  string
+	Type  string
+	Group string
+	Len   string
+	Class string
+	CType string // The declared C type
+}
+
+func (cmd libCommand) Sig() string {
+	params := make([]string, len(cmd.Params))
+	for i, par := range cmd.Params {
+		params[i] = par.Name + " " + par.Type
+	}
+	sig := "(" + strings.Join(params, ", ") + ")"
+	if cmd.Return != "" {
+		sig += " " + cmd.Return
+	}
+	return sig
+}
+
+// genLib generates the methods of lib, which implements every command.
+// The comment in docs for each command is returned with its signature, to document its interface method.
+func genLib(buf *bytes.Buffer, reg *Registry, docs map[string]string) (cmds map[string]libCommand) {
+	cmds = make(map[string]libCommand, len(reg.Commands))
 	names := make([]string, 0, len(reg.Commands))
 commands:
 	for _, cmd := range reg.Commands {
 		if strings.HasPrefix(cmd.Name, "glDebugMessageCallback") {
 			// We don't generate any wrapper code for this function, it's manually defined in debug.go
 			names = append(names, cmd.Name)
-			cmdSigs[cmd.Name] = "(callback func(source, type_, id, severity uint32, message string))"
+			cmds[cmd.Name] = libCommand{Params: []libParam{
+				{Name: "callback", Type: "func(source, type_, id, severity uint32, message string)"},
+			}}
 			continue
 		}
 
 		params := make([]string, len(cmd.Params))
 		args := make([]string, len(cmd.Params))
+		lcmd := libCommand{Params: make([]libParam, len(cmd.Params)), ReturnClass: cmd.ReturnClass, ReturnGroup: cmd.ReturnGroup, Doc: docs[cmd.Name]}
 		for i, par := range cmd.Params {
 			ty, ok := goType(reg.Types, par.Type)
 			if !ok {
 				continue commands
 			}
+			ty = HandleType(ty, par.Class)
 			cty, _ := cType(reg.Types, par.Type, true)
 
 			name := par.Name
@@ -110,6 +161,7 @@ commands:
 			}
 
 			params[i] = name + ty
+			lcmd.Params[i] = libParam{name, ty[1:], par.Group, par.Len, par.Class, par.Decl}
 			if cty[0] == '*' {
 				args[i] = fmt.Sprintf("(%s)(unsafe.Pointer(%s))", cty, name)
 			} else {
@@ -123,34 +175,61 @@ commands:
 		if !ok {
 			continue
 		}
+		retTy = HandleType(retTy, cmd.ReturnClass)
+		lcmd.Return = strings.TrimPrefix(retTy, " ")
 
 		names = append(names, cmd.Name)
-		cmdSigs[cmd.Name] = fmt.Sprintf("(%s)%s", paramS, retTy)
+		cmds[cmd.Name] = lcmd
 		fmt.Fprintf(buf, "func (gl *lib) %s(%s)%s {\n", strings.TrimPrefix(cmd.Name, "gl"), paramS, retTy)
-		cast := false
+		// glGetError must not be checked, as doing so would discard the error it is about to return
+		check := cmd.Name != "glGetError"
 		if retTy != "" {
-			cast = true
-			fmt.Fprintf(buf, "return (%s)(", retTy)
+			if check {
+				buf.WriteString("ret := ")
+			} else {
+				buf.WriteString("return ")
+			}
+			fmt.Fprintf(buf, "(%s)(", retTy)
 		}
 		fmt.Fprintf(buf, "C.gllCall_%s(gl.%s, %s)", cmd.Name, cmd.Name, argS)
-		if cast {
+		if retTy != "" {
 			buf.WriteByte(')')
 		}
-		buf.WriteString("\n}\n")
+		buf.WriteByte('\n')
+		if check {
+			fmt.Fprintf(buf, "if checkErrors {\ngl.checkError(%q", cmd.Name)
+			for _, par := range lcmd.Params {
+				fmt.Fprintf(buf, ", %s", par.Name)
+			}
+			buf.WriteString(")\n}\n")
+			if retTy != "" {
+				buf.WriteString("return ret\n")
+			}
+		}
+		buf.WriteString("}\n")
 	}
 
 	buf.WriteString("type lib struct {\n")
 	buf.WriteString("debugState\n")
+	buf.WriteString("errorState\n")
+	buf.WriteString("loadState\n")
+	buf.WriteString("getProcAddr func(name string) unsafe.Pointer\n")
 	for _, name := range names {
 		buf.WriteString(name)
 		buf.WriteString(" unsafe.Pointer\n")
 	}
 	buf.WriteString("}\n")
 
-	return cmdSigs
+	buf.WriteString("func (gl *lib) ProcAddress(name string) unsafe.Pointer {\nswitch name {\n")
+	for _, name := range names {
+		fmt.Fprintf(buf, "case %q:\nreturn gl.%[1]s\n", name)
+	}
+	buf.WriteString("}\nreturn gl.getProcAddr(name)\n}\n")
+
+	return cmds
 }
 
-func genVersions(buf *bytes.Buffer, cmdSigs map[string]string, reg *Registry) {
+func genVersions(buf *bytes.Buffer, cmdSigs map[string]libCommand, reg *Registry) {
 	cmds := make(map[string]struct{})
 	// FIXME: This is a selection sort. Selection sort is trash, but as of writing there are only 20 OpenGL versions, so it's fine
 	// Also the elements are sorted in the Khronos registry and this selection sort is optimized for that case
@@ -171,7 +250,10 @@ func genVersions(buf *bytes.Buffer, cmdSigs map[string]string, reg *Registry) {
 		}
 	}
 }
-func genVersion(buf *bytes.Buffer, cmdSigs map[string]string, v int, cmdMap map[string]struct{}) {
+
+// genVersion generates the interface of a version.
+// Every command is documented, so the docs of each interface are complete.
+func genVersion(buf *bytes.Buffer, cmdSigs map[string]libCommand, v int, cmdMap map[string]struct{}) {
 	cmds := make([]string, 0, len(cmdMap))
 	for cmd := range cmdMap {
 		cmds = append(cmds, cmd)
@@ -180,8 +262,9 @@ func genVersion(buf *bytes.Buffer, cmdSigs map[string]string, v int, cmdMap map[
 
 	fmt.Fprintf(buf, "type GL%d interface {\nExtensions\n", v)
 	for _, cmd := range cmds {
+		buf.WriteString(cmdSigs[cmd].Doc)
 		buf.WriteString(strings.TrimPrefix(cmd, "gl"))
-		buf.WriteString(cmdSigs[cmd])
+		buf.WriteString(cmdSigs[cmd].Sig())
 		buf.WriteByte('\n')
 	}
 	fmt.Fprintf(buf, "}\nfunc New%d(getProcAddr func(name string) unsafe.Pointer) GL%[1]d {\n", v)
@@ -191,11 +274,20 @@ func genVersion(buf *bytes.Buffer, cmdSigs map[string]string, v int, cmdMap map[
 	}
 	buf.WriteString("}\n")
 	buf.WriteString("gl.initExtensions(getProcAddr)\n")
+	fmt.Fprintf(buf, "gl.initAliases(getProcAddr, %d)\n", v)
 	buf.WriteString("return gl\n")
 	buf.WriteString("}\n")
 }
 
-func genExtensions(buf *bytes.Buffer, cmdSigs map[string]string, reg *Registry) {
+// genExtensions generates the Extensions interface.
+// Commands in a version are documented in the interface of the version, so only the others are documented here.
+func genExtensions(buf *bytes.Buffer, cmdSigs map[string]libCommand, reg *Registry) {
+	core := make(map[string]bool)
+	for _, feat := range reg.Features {
+		for _, cmd := range feat.Commands {
+			core[cmd] = true
+		}
+	}
 	cmds := make(map[string]struct{})
 	buf.WriteString("type Extensions interface {\n")
 	for _, ext := range reg.Extensions {
@@ -205,13 +297,17 @@ func genExtensions(buf *bytes.Buffer, cmdSigs map[string]string, reg *Registry)
 			}
 			if sig, ok := cmdSigs[cmd]; ok {
 				cmds[cmd] = struct{}{}
+				if !core[cmd] {
+					buf.WriteString(sig.Doc)
+				}
 				buf.WriteString(strings.TrimPrefix(cmd, "gl"))
-				buf.WriteString(sig)
+				buf.WriteString(sig.Sig())
 				buf.WriteByte('\n')
 			}
 		}
 	}
 	buf.WriteString("}\nfunc (gl *lib) initExtensions(getProcAddr func(name string) unsafe.Pointer) {\n")
+	buf.WriteString("gl.getProcAddr = getProcAddr\n")
 	for _, ext := range reg.Extensions {
 		for _, cmd := range ext.Commands {
 			if _, ok := cmds[cmd]; ok {
@@ -222,26 +318,323 @@ func genExtensions(buf *bytes.Buffer, cmdSigs map[string]string, reg *Registry)
 	buf.WriteString("}\n")
 }
 
+// genAliases generates initAliases, which loads core commands the driver does not provide from their ARB, EXT or KHR aliases.
+// Only commands in the GL version being loaded are filled in.
+func genAliases(buf *bytes.Buffer, cmds map[string]libCommand, reg *Registry) {
+	aliases := make(map[string][]string)
+	for _, cmd := range reg.Commands {
+		if cmd.Alias == "" || !aliasSuffix(cmd.Name) {
+			continue
+		}
+		_, ok1 := cmds[cmd.Name]
+		_, ok2 := cmds[cmd.Alias]
+		if ok1 && ok2 {
+			aliases[cmd.Alias] = append(aliases[cmd.Alias], cmd.Name)
+		}
+	}
+
+	buf.WriteString("func (gl *lib) initAliases(getProcAddr func(name string) unsafe.Pointer, version int) {\n")
+	buf.WriteString("if !aliasFallback {\nreturn\n}\n")
+	done := make(map[string]bool)
+	for _, feat := range reg.Features {
+		for _, cmd := range feat.Commands {
+			if done[cmd] || len(aliases[cmd]) == 0 {
+				continue
+			}
+			done[cmd] = true
+			sort.Strings(aliases[cmd])
+			fmt.Fprintf(buf, "if version >= %d && gl.%s == nil {\ngl.%[2]s = gl.loadAlias(getProcAddr, %[2]q", feat.Version, cmd)
+			for _, alias := range aliases[cmd] {
+				fmt.Fprintf(buf, ", %q", alias)
+			}
+			buf.WriteString(")\n}\n")
+		}
+	}
+	buf.WriteString("}\n")
+}
+
+func aliasSuffix(name string) bool {
+	for _, suffix := range []string{"ARB", "EXT", "KHR"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// latestCommands returns the sorted names of every command in the latest GL version and its extensions.
+// Versions are cumulative, so this is every command from every feature and extension.
+func latestCommands(cmds map[string]libCommand, reg *Registry) []string {
+	latest := make(map[string]struct{})
+	for _, feat := range reg.Features {
+		for _, cmd := range feat.Commands {
+			if _, ok := cmds[cmd]; ok {
+				latest[cmd] = struct{}{}
+			}
+		}
+	}
+	for _, ext := range reg.Extensions {
+		for _, cmd := range ext.Commands {
+			if _, ok := cmds[cmd]; ok {
+				latest[cmd] = struct{}{}
+			}
+		}
+	}
+	names := make([]string, 0, len(latest))
+	for cmd := range latest {
+		names = append(names, cmd)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func genHooks(buf *bytes.Buffer, cmds map[string]libCommand, reg *Registry) {
+	names := latestCommands(cmds, reg)
+	buf.WriteString("const (\n")
+	for i, name := range names {
+		fmt.Fprintf(buf, "Cmd%s", strings.TrimPrefix(name, "gl"))
+		if i == 0 {
+			buf.WriteString(" CommandID = iota")
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString(")\n")
+
+	buf.WriteString("var commandInfo = [...]CommandInfo{\n")
+	for _, name := range names {
+		cmd := cmds[name]
+		fmt.Fprintf(buf, "Cmd%s: {%q, ", strings.TrimPrefix(name, "gl"), name)
+		if len(cmd.Params) == 0 {
+			buf.WriteString("nil")
+		} else {
+			buf.WriteString("[]ParamInfo{")
+			for _, par := range cmd.Params {
+				fmt.Fprintf(buf, "{%q, %q, %q, %q, %q, %q}, ", par.Name, par.Type, par.Group, par.Len, par.Class, par.CType)
+			}
+			buf.WriteString("}")
+		}
+		fmt.Fprintf(buf, ", %q, %q, %q},\n", cmd.Return, cmd.ReturnClass, cmd.ReturnGroup)
+	}
+	buf.WriteString("}\n")
+
+	buf.WriteString("func callCommand(gl GL460, cmd CommandID, args []Arg) Arg {\nswitch cmd {\n")
+	for _, name := range names {
+		cmd := cmds[name]
+		method := strings.TrimPrefix(name, "gl")
+		args := make([]string, len(cmd.Params))
+		for i, par := range cmd.Params {
+			args[i] = fmt.Sprintf("args[%d].(%s)", i, par.Type)
+		}
+		fmt.Fprintf(buf, "case Cmd%s:\n", method)
+		if cmd.Return != "" {
+			buf.WriteString("return ")
+		}
+		fmt.Fprintf(buf, "gl.%s(%s)\n", method, strings.Join(args, ", "))
+	}
+	buf.WriteString("}\nreturn nil\n}\n")
+
+	for _, name := range names {
+		cmd := cmds[name]
+		method := strings.TrimPrefix(name, "gl")
+		args := make([]string, len(cmd.Params))
+		for i, par := range cmd.Params {
+			args[i] = par.Name
+		}
+		argS := strings.Join(args, ", ")
+
+		fmt.Fprintf(buf, "func (gl *hooked) %s%s {\n", method, cmd.Sig())
+		fmt.Fprintf(buf, "gl.hooks.Before(Cmd%s, []Arg{%s})\n", method, argS)
+		if cmd.Return == "" {
+			fmt.Fprintf(buf, "gl.next.%s(%s)\n", method, argS)
+			fmt.Fprintf(buf, "gl.hooks.After(Cmd%s, nil)\n", method)
+		} else {
+			fmt.Fprintf(buf, "ret := gl.next.%s(%s)\n", method, argS)
+			fmt.Fprintf(buf, "gl.hooks.After(Cmd%s, ret)\n", method)
+			buf.WriteString("return ret\n")
+		}
+		buf.WriteString("}\n")
+
+		fmt.Fprintf(buf, "func (gl dispatched) %s%s {\n", method, cmd.Sig())
+		if cmd.Return == "" {
+			fmt.Fprintf(buf, "gl(Cmd%s, []Arg{%s})\n", method, argS)
+		} else {
+			fmt.Fprintf(buf, "return gl(Cmd%s, []Arg{%s}).(%s)\n", method, argS, cmd.Return)
+		}
+		buf.WriteString("}\n")
+	}
+}
+
+// GenerateFake generates the glfake package, which implements every GL interface in pure Go
+func GenerateFake(reg *Registry) (src []byte, err error) {
+	g := NewGenerator(Options{})
+	g.Register("glfake/gl.go", Fake)
+	return g.generate("glfake/gl.go", reg)
+}
+
+// Fake is the Emitter for the glfake package
+func Fake(reg *Registry, opts Options) (src []byte, err error) {
+	buf := bytes.Buffer{}
+	buf.WriteString("package glfake\n\n")
+	buf.WriteString("import (\n\"unsafe\"\n\n\"github.com/vktec/gll\"\n)\n\n")
+	// Only the command signatures are needed, not the gll implementation
+	cmds := genLib(&bytes.Buffer{}, reg, nil)
+	for _, name := range latestCommands(cmds, reg) {
+		cmd := cmds[name]
+		method := strings.TrimPrefix(name, "gl")
+		params := make([]string, len(cmd.Params))
+		args := []string{"gll.Cmd" + method}
+		for i, par := range cmd.Params {
+			params[i] = par.Name + " " + fakeType(par.Type)
+			args = append(args, par.Name)
+		}
+		argS := strings.Join(args, ", ")
+
+		fmt.Fprintf(&buf, "func (gl *GL) %s(%s)", method, strings.Join(params, ", "))
+		if cmd.Return == "" {
+			fmt.Fprintf(&buf, " {\ngl.call(%s)\n}\n", argS)
+		} else {
+			fmt.Fprintf(&buf, " (ret %s) {\n", fakeType(cmd.Return))
+			fmt.Fprintf(&buf, "if r := gl.call(%s); r != nil {\nret = r.(%s)\n}\n", argS, fakeType(cmd.Return))
+			buf.WriteString("return\n}\n")
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// fakeType qualifies the types defined by gll in a Go type
+func fakeType(ty string) string {
+	base := strings.TrimLeft(ty, "*")
+	if base == "" || base[0] < 'A' || base[0] > 'Z' {
+		return ty
+	}
+	return ty[:len(ty)-len(base)] + "gll." + base
+}
+
 func genTypes(buf *bytes.Buffer) {
 	buf.WriteString(`
 type GLhandleARB C.GLhandleARB
 type GLsync C.GLsync
+type GLeglImageOES C.GLeglImageOES
+type GLeglClientBufferEXT C.GLeglClientBufferEXT
+type CLContext C.struct__cl_context
+type CLEvent C.struct__cl_event
+type GLVULKANPROCNV C.GLVULKANPROCNV
+
+// The names of GL objects, distinguished by class so that one cannot be passed in place of another
+type (
+	Buffer      uint32
+	Framebuffer uint32
+	Program     uint32
+	Query       uint32
+	Sampler     uint32
+	Shader      uint32
+	Texture     uint32
+	VertexArray uint32
+)
 `)
 }
 
 func genEnums(buf *bytes.Buffer, reg *Registry) {
+	values := make(map[string]string, len(reg.Enums))
+	for _, enum := range reg.Enums {
+		values[enum.Name] = enum.Value
+	}
+
 	buf.WriteString("const (\n")
 	for _, enum := range reg.Enums {
-		name := strings.TrimPrefix(enum.Name, "GL_")
-		// A few names start with digits, don't remove the GL_ prefix for those
-		if '0' <= name[0] && name[0] <= '9' {
-			name = enum.Name
+		name := enumName(enum.Name)
+		switch {
+		case enum.Alias != "" && sameValue(values[enum.Alias], enum.Value):
+			// Aliases take the type of the enum they alias
+			fmt.Fprintf(buf, "%s = %s\n", name, enumName(enum.Alias))
+		case enumType(enum) != "":
+			fmt.Fprintf(buf, "%s %s = %s\n", name, enumType(enum), enum.Value)
+		default:
+			fmt.Fprintf(buf, "%s = %s\n", name, enum.Value)
 		}
-		fmt.Fprintf(buf, "%s = %s\n", name, enum.Value)
 	}
 	buf.WriteString(")\n")
 }
 
+// enumName returns the Go name of an enum
+func enumName(cName string) string {
+	name := strings.TrimPrefix(cName, "GL_")
+	// A few names start with digits, don't remove the GL_ prefix for those
+	if '0' <= name[0] && name[0] <= '9' {
+		return cName
+	}
+	return name
+}
+
+// enumType returns the Go type of an enum's value, or "" if the value is an untyped GLenum.
+// GLenum values are left untyped, as they are also passed as GLint and GLbitfield.
+func enumType(enum Enum) string {
+	switch enum.ValueType {
+	case "u":
+		return "uint32"
+	case "ull":
+		return "uint64"
+	}
+	return ""
+}
+
+func sameValue(a, b string) bool {
+	x, errX := strconv.ParseUint(a, 0, 64)
+	y, errY := strconv.ParseUint(b, 0, 64)
+	if errX != nil || errY != nil {
+		return a != "" && a == b
+	}
+	return x == y
+}
+
+func genEnumGroups(buf *bytes.Buffer, reg *Registry) {
+	groups := make(map[string][]Enum)
+	bitmask := make(map[string]bool)
+	for _, enum := range reg.Enums {
+		if enum.Type == "" {
+			continue
+		}
+		for _, group := range strings.Split(enum.Type, ",") {
+			groups[group] = append(groups[group], enum)
+			bitmask[group] = bitmask[group] || enum.Bitmask
+		}
+	}
+	names := make([]string, 0, len(groups))
+	for group := range groups {
+		names = append(names, group)
+	}
+	sort.Strings(names)
+
+	buf.WriteString("var enumGroups = map[string]map[uint32]string{\n")
+	for _, group := range names {
+		fmt.Fprintf(buf, "%q: {\n", group)
+		seen := make(map[uint64]struct{})
+		for _, enum := range groups[group] {
+			// Enums that don't fit in a GLenum can't be passed as one
+			v, err := strconv.ParseUint(enum.Value, 0, 32)
+			if err != nil {
+				continue
+			}
+			// Several enums may share a value; the first one in the registry wins
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			fmt.Fprintf(buf, "%s: %q,\n", enum.Value, enum.Name)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+
+	buf.WriteString("var bitmaskGroups = map[string]bool{\n")
+	for _, group := range names {
+		if bitmask[group] {
+			fmt.Fprintf(buf, "%q: true,\n", group)
+		}
+	}
+	buf.WriteString("}\n")
+}
+
 func ptrParse(ty string) (name, ptr string) {
 	name = strings.TrimRight(ty, " \t\n")
 	for {
@@ -318,6 +711,24 @@ func cType(types map[string]Type, name string, cgo bool) (t string, ok bool) {
 		t = "GLhandleARB"
 	case GLsync:
 		t = "GLsync"
+	case GLeglImageOES:
+		t = "GLeglImageOES"
+	case GLeglClientBufferEXT:
+		t = "GLeglClientBufferEXT"
+	case CLContext:
+		if cgo {
+			t = "struct__cl_context"
+		} else {
+			t = "struct _cl_context"
+		}
+	case CLEvent:
+		if cgo {
+			t = "struct__cl_event"
+		} else {
+			t = "struct _cl_event"
+		}
+	case GLVULKANPROCNV:
+		t = "GLVULKANPROCNV"
 	case GLDEBUGPROC:
 		panic("GLDEBUGPROC has no C representation")
 
@@ -334,6 +745,35 @@ func cType(types map[string]Type, name string, cgo bool) (t string, ok bool) {
 	}
 }
 
+// GoType returns the Go type gll uses for a C type from the registry, eg. *uint32 for "GLuint *".
+// ok is false if the type is not supported.
+func GoType(types map[string]Type, cType string) (t string, ok bool) {
+	t, ok = goType(types, cType)
+	return strings.TrimPrefix(t, " "), ok
+}
+
+// handleTypes maps the classes of GL objects to the Go types of their names
+var handleTypes = map[string]string{
+	"buffer":       "Buffer",
+	"framebuffer":  "Framebuffer",
+	"program":      "Program",
+	"query":        "Query",
+	"sampler":      "Sampler",
+	"shader":       "Shader",
+	"texture":      "Texture",
+	"vertex array": "VertexArray",
+}
+
+// HandleType returns the Go type gll uses for a value of the given Go type and object class, eg. *Buffer for *uint32 and "buffer".
+// Values of other types or classes keep their Go type.
+func HandleType(goType, class string) string {
+	handle, ok := handleTypes[class]
+	if !ok || strings.TrimLeft(goType, " *") != "uint32" {
+		return goType
+	}
+	return strings.TrimSuffix(goType, "uint32") + handle
+}
+
 func goType(types map[string]Type, name string) (t string, ok bool) {
 	name, ptr := ptrParse(name)
 	if name == "void" {
@@ -391,6 +831,16 @@ func goType(types map[string]Type, name string) (t string, ok bool) {
 		t = "GLhandleARB"
 	case GLsync:
 		t = "GLsync"
+	case GLeglImageOES:
+		t = "GLeglImageOES"
+	case GLeglClientBufferEXT:
+		t = "GLeglClientBufferEXT"
+	case CLContext:
+		t = "CLContext"
+	case CLEvent:
+		t = "CLEvent"
+	case GLVULKANPROCNV:
+		t = "GLVULKANPROCNV"
 	case GLDEBUGPROC:
 		t = "func(source, type_, id, severity uint32, message string)"
 
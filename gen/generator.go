@@ -0,0 +1,159 @@
+package gen
+
+import (
+	"fmt"
+	"go/format"
+)
+
+// GeneratorVersion identifies the code generated by this package.
+// It is increased whenever the output for the same registry changes.
+const GeneratorVersion = 2
+
+// Options selects what a Generator generates. The zero value generates gll.
+type Options struct {
+	Package string   // The package name of the bindings; defaults to gll
+	Cgo     []string // cgo directives for the bindings; defaults to linking libGL on Linux and opengl32 on Windows
+
+	API        string   // The API to generate, eg. gl or gles2; defaults to gl
+	Profile    string   // If core, commands removed from the core profile are omitted
+	Version    int      // The latest version to generate, eg. 330 for 3.3; 0 generates every version
+	Extensions []string // The names of the extensions to generate; nil generates every extension supporting the API
+
+	RefPages map[string]RefPage // Documentation for the commands, by name; see LoadRefPages
+}
+
+func (opts Options) withDefaults() Options {
+	if opts.Package == "" {
+		opts.Package = "gll"
+	}
+	if opts.Cgo == nil {
+		opts.Cgo = []string{"#cgo linux pkg-config: gl", "#cgo windows LDFLAGS: -lopengl32"}
+	}
+	if opts.API == "" {
+		opts.API = "gl"
+	}
+	return opts
+}
+
+// all reports whether every command in the registry is selected
+func (opts Options) all() bool {
+	return opts.Profile == "" && opts.Version == 0 && opts.Extensions == nil
+}
+
+// An Emitter generates the Go source of one file from a registry filtered by a Generator.
+// The source is formatted by the Generator.
+type Emitter func(reg *Registry, opts Options) (src []byte, err error)
+
+// Generator generates files from a registry using a list of Emitters
+type Generator struct {
+	Options Options
+
+	files    []string
+	emitters map[string]Emitter
+}
+
+// NewGenerator returns a Generator that generates gll's bindings as gl.go
+func NewGenerator(opts Options) *Generator {
+	g := &Generator{Options: opts, emitters: make(map[string]Emitter)}
+	g.Register("gl.go", Bindings)
+	return g
+}
+
+// Register adds an Emitter that generates the named file, replacing any Emitter previously registered for it
+func (g *Generator) Register(file string, e Emitter) {
+	if _, ok := g.emitters[file]; !ok {
+		g.files = append(g.files, file)
+	}
+	g.emitters[file] = e
+}
+
+// Generate runs every registered Emitter on reg filtered by the Generator's options,
+// and returns the source of each file by name
+func (g *Generator) Generate(reg *Registry) (files map[string][]byte, err error) {
+	files = make(map[string][]byte, len(g.files))
+	for _, file := range g.files {
+		if files[file], err = g.generate(file, reg); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func (g *Generator) generate(file string, reg *Registry) ([]byte, error) {
+	opts := g.Options.withDefaults()
+	src, err := g.emitters[file](g.Filter(reg), opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	if src, err = format.Source(src); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	return src, nil
+}
+
+// Filter returns the enums, features, extensions and commands of reg selected by the Generator's options
+func (g *Generator) Filter(reg *Registry) *Registry {
+	opts := g.Options.withDefaults()
+	support := opts.API
+	if opts.API == "gl" && opts.Profile == "core" {
+		support = "glcore"
+	}
+	removed := make(map[string]bool)
+	for _, feat := range reg.Features {
+		if feat.API != opts.API || opts.Version != 0 && feat.Version > opts.Version {
+			continue
+		}
+		for _, rem := range feat.Removed {
+			if opts.Profile != "" && (rem.Profile == "" || rem.Profile == opts.Profile) {
+				for _, cmd := range rem.Commands {
+					removed[cmd] = true
+				}
+			}
+		}
+	}
+	required := make(map[string]bool)
+	keep := func(cmds []string) []string {
+		kept := make([]string, 0, len(cmds))
+		for _, cmd := range cmds {
+			if !removed[cmd] {
+				kept = append(kept, cmd)
+				required[cmd] = true
+			}
+		}
+		return kept
+	}
+
+	out := &Registry{Types: reg.Types}
+	for _, enum := range reg.Enums {
+		if enum.API == "" || enum.API == opts.API {
+			out.Enums = append(out.Enums, enum)
+		}
+	}
+	for _, feat := range reg.Features {
+		if feat.API == opts.API && (opts.Version == 0 || feat.Version <= opts.Version) {
+			feat.Commands = keep(feat.Commands)
+			out.Features = append(out.Features, feat)
+		}
+	}
+	for _, ext := range reg.Extensions {
+		if contains(ext.Supported, support) && (opts.Extensions == nil || contains(opts.Extensions, ext.Name)) {
+			ext.Commands = keep(ext.Commands)
+			out.Extensions = append(out.Extensions, ext)
+		}
+	}
+	for _, cmd := range reg.Commands {
+		if required[cmd.Name] || opts.all() {
+			out.Commands = append(out.Commands, cmd)
+		}
+	}
+	return out
+}
+
+func contains(strs []string, s string) bool {
+	for _, str := range strs {
+		if str == s {
+			return true
+		}
+	}
+	return false
+}
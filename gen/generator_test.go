@@ -0,0 +1,182 @@
+package gen
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+)
+
+func parseTest(t *testing.T) *Registry {
+	return parseTestWith(t, Parse)
+}
+
+func parseTestWith(t *testing.T, parse func(io.Reader) (*Registry, error)) *Registry {
+	f, err := os.Open("testdata/test.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	reg, err := parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return reg
+}
+
+func TestFilter(t *testing.T) {
+	// Every API is parsed, so the Generator can select OpenGL ES
+	reg := parseTestWith(t, ParseAll)
+	commands := func(reg *Registry) []string {
+		names := []string{}
+		for _, cmd := range reg.Commands {
+			names = append(names, cmd.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		opts          Options
+		features      []string
+		exts          []string
+		cmds          []string
+		activeProgram string // The value of GL_ACTIVE_PROGRAM_EXT
+	}{
+		{Options{}, []string{"GL_VERSION_3_2", "GL_VERSION_4_3"}, []string{"GL_ARB_clear_texture", "GL_EXT_direct_state_access"},
+			[]string{"glClientAttribDefaultEXT", "glDeleteBuffers", "glDeleteBuffersARB", "glCreateProgram"}, "0x8B8D"},
+		{Options{Profile: "core"}, []string{"GL_VERSION_3_2", "GL_VERSION_4_3"}, []string{"GL_ARB_clear_texture"},
+			[]string{"glCreateProgram"}, "0x8B8D"},
+		{Options{Version: 320, Extensions: []string{"GL_EXT_direct_state_access"}}, []string{"GL_VERSION_3_2"}, []string{"GL_EXT_direct_state_access"},
+			[]string{"glClientAttribDefaultEXT", "glCreateProgram"}, "0x8B8D"},
+		{Options{API: "gles2"}, []string{"GL_ES_VERSION_2_0"}, []string{},
+			[]string{"glClientAttribDefaultEXT", "glDeleteBuffers", "glDeleteBuffersARB", "glCreateProgram"}, "0x8259"},
+		{Options{API: "gles2", Version: 200}, []string{"GL_ES_VERSION_2_0"}, []string{},
+			[]string{"glDeleteBuffers"}, "0x8259"},
+	}
+	for _, test := range tests {
+		out := NewGenerator(test.opts).Filter(reg)
+		features := []string{}
+		for _, feat := range out.Features {
+			features = append(features, feat.Name)
+		}
+		exts := []string{}
+		for _, ext := range out.Extensions {
+			exts = append(exts, ext.Name)
+		}
+		if !reflect.DeepEqual(features, test.features) {
+			t.Errorf("%+v: expected features %v, got %v", test.opts, test.features, features)
+		}
+		if !reflect.DeepEqual(exts, test.exts) {
+			t.Errorf("%+v: expected extensions %v, got %v", test.opts, test.exts, exts)
+		}
+		if cmds := commands(out); !reflect.DeepEqual(cmds, test.cmds) {
+			t.Errorf("%+v: expected commands %v, got %v", test.opts, test.cmds, cmds)
+		}
+		values := []string{}
+		for _, enum := range out.Enums {
+			if enum.Name == "GL_ACTIVE_PROGRAM_EXT" {
+				values = append(values, enum.Value)
+			}
+		}
+		if !reflect.DeepEqual(values, []string{test.activeProgram}) {
+			t.Errorf("%+v: expected GL_ACTIVE_PROGRAM_EXT to be %s, got %v", test.opts, test.activeProgram, values)
+		}
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	reg := parseTest(t)
+	g := NewGenerator(Options{Package: "gl43", Profile: "core"})
+	var emitted *Registry
+	g.Register("names.go", func(reg *Registry, opts Options) ([]byte, error) {
+		emitted = reg
+		buf := &bytes.Buffer{}
+		buf.WriteString("package " + opts.Package + "\nvar Names = []string{")
+		for _, cmd := range reg.Commands {
+			buf.WriteString(`"` + cmd.Name + `",`)
+		}
+		buf.WriteString("}\n")
+		return buf.Bytes(), nil
+	})
+	files, err := g.Generate(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 2 || files["gl.go"] == nil {
+		t.Fatalf("Expected gl.go and names.go, got %d files", len(files))
+	}
+	if !bytes.HasPrefix(files["gl.go"], []byte("package gl43\n")) {
+		t.Error("Incorrect package name in gl.go")
+	}
+	if !reflect.DeepEqual(emitted, g.Filter(reg)) {
+		t.Error("Emitter did not receive the filtered registry")
+	}
+	expected := "package gl43\n\nvar Names = []string{\"glCreateProgram\"}\n"
+	if src := string(files["names.go"]); src != expected {
+		t.Errorf("Incorrect source for names.go:\n%s", src)
+	}
+}
+
+func TestEnums(t *testing.T) {
+	src, err := Generate(parseTest(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		`DRAW_FRAMEBUFFER_BINDING\s+= 0x8CA6`,
+		`DRAW_FRAMEBUFFER_BINDING_EXT\s+= DRAW_FRAMEBUFFER_BINDING`,
+		`INVALID_INDEX\s+uint32\s+= 0xFFFFFFFF`,
+		`TIMEOUT_IGNORED\s+uint64\s+= 0xFFFFFFFFFFFFFFFF`,
+		`TIMEOUT_IGNORED_APPLE\s+= TIMEOUT_IGNORED`,
+		`"ClientAttribMask": true,`,
+	} {
+		if !regexp.MustCompile(`\n\t` + expected + `\n`).Match(src) {
+			t.Errorf("Generated enums do not match %q", expected)
+		}
+	}
+}
+
+func TestEnumsKept(t *testing.T) {
+	// Enums are constants whether or not a feature requires them, so regenerating from a full registry keeps every GL enum
+	reg := parseTestWith(t, ParseAll)
+	src, err := Generate(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, enum := range reg.Enums {
+		defined := regexp.MustCompile(`\n\t` + enumName(enum.Name) + `\s.*= ` + regexp.QuoteMeta(enum.Value) + `\n`).Match(src)
+		if kept := enum.API == "" || enum.API == "gl"; enum.Alias == "" && defined != kept {
+			t.Errorf("%s (%s) = %s: expected defined to be %v", enum.Name, enum.API, enum.Value, kept)
+		}
+	}
+}
+
+func TestHandles(t *testing.T) {
+	// The emitters are called directly, as glDeleteBuffers is only in a gles2 feature
+	reg := parseTestWith(t, ParseAll)
+	src, err := Bindings(reg, Options{}.withDefaults())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fake, err := Fake(reg, Options{}.withDefaults())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expected := range []string{
+		"func (gl *lib) DeleteBuffers(n int32, buffers *Buffer) {\n",
+		"func (gl *lib) CreateProgram() Program {\n",
+	} {
+		if !bytes.Contains(src, []byte(expected)) {
+			t.Errorf("Generated code does not contain %q", expected)
+		}
+	}
+	if !bytes.Contains(fake, []byte("func (gl *GL) DeleteBuffers(n int32, buffers *gll.Buffer) {\n")) {
+		t.Error("Fake does not use gll.Buffer")
+	}
+	if HandleType("*uint32", "renderbuffer") != "*uint32" || HandleType("int32", "buffer") != "int32" {
+		t.Error("HandleType changed a type without a handle type")
+	}
+}
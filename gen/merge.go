@@ -0,0 +1,67 @@
+package gen
+
+import "fmt"
+
+// Merge combines registries, such as the Khronos registry and a registry of private extensions.
+// Types, commands and enums defined identically by more than one registry are included once;
+// conflicting definitions, and features or extensions defined more than once, are an error.
+func Merge(regs ...*Registry) (*Registry, error) {
+	out := &Registry{Types: make(map[string]Type)}
+	cmds := make(map[string]Command)
+	enums := make(map[string]string)
+	features := make(map[string]bool)
+	exts := make(map[string]bool)
+	for _, reg := range regs {
+		for name, ty := range reg.Types {
+			if old, ok := out.Types[name]; ok && old != ty {
+				return nil, fmt.Errorf("type %s defined as both %s and %s", name, old, ty)
+			}
+			out.Types[name] = ty
+		}
+
+		// Check against the previous registries only, as the Khronos registry defines some enums more than once
+		merged := make(map[string]bool)
+		for _, cmd := range reg.Commands {
+			if old, ok := cmds[cmd.Name]; ok && !merged[cmd.Name] {
+				if old.Signature() != cmd.Signature() {
+					return nil, fmt.Errorf("command %s defined as both %s and %s", cmd.Name, old.Signature(), cmd.Signature())
+				}
+				continue
+			}
+			merged[cmd.Name] = true
+			cmds[cmd.Name] = cmd
+			out.Commands = append(out.Commands, cmd)
+		}
+		merged = make(map[string]bool)
+		for _, enum := range reg.Enums {
+			key := enumKey(enum)
+			if value, ok := enums[key]; ok && !merged[key] {
+				if value != enum.Value {
+					return nil, fmt.Errorf("enum %s defined as both %s and %s", key, value, enum.Value)
+				}
+				continue
+			}
+			if _, ok := enums[key]; !ok {
+				merged[key] = true
+				enums[key] = enum.Value
+			}
+			out.Enums = append(out.Enums, enum)
+		}
+
+		for _, feature := range reg.Features {
+			if features[feature.Name] {
+				return nil, fmt.Errorf("feature %s defined more than once", feature.Name)
+			}
+			features[feature.Name] = true
+			out.Features = append(out.Features, feature)
+		}
+		for _, ext := range reg.Extensions {
+			if exts[ext.Name] {
+				return nil, fmt.Errorf("extension %s defined more than once", ext.Name)
+			}
+			exts[ext.Name] = true
+			out.Extensions = append(out.Extensions, ext)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,53 @@
+package gen
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	f, err := os.Open("testdata/private.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	private, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg, err := Merge(parseTest(t), private)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reg.Commands) != 5 || reg.Commands[4].Name != "glGetFrameCounterVENDOR" {
+		t.Errorf("Incorrect commands: %v", reg.Commands)
+	}
+	if len(reg.Enums) != 11 || reg.Enums[10].Name != "GL_FRAME_COUNTER_VENDOR" {
+		t.Errorf("Incorrect enums: %v", reg.Enums)
+	}
+	if len(reg.Extensions) != 3 || reg.Extensions[2].Name != "GL_VENDOR_frame_counter" {
+		t.Errorf("Incorrect extensions: %v", reg.Extensions)
+	}
+	src, err := Generate(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(src, []byte("\tGetFrameCounterVENDOR(pname uint32) uint32\n")) {
+		t.Error("Private command missing from generated code")
+	}
+
+	conflicts := []*Registry{
+		{Types: map[string]Type{"GLuint": Int32}},
+		{Commands: []Command{{"glCreateProgram", nil, "GLenum", "", "", "", ""}}},
+		{Enums: []Enum{{Name: "GL_CLIENT_PIXEL_STORE_BIT", Value: "0x2"}}},
+		{Features: []Feature{{Version: 430, Name: "GL_VERSION_4_3", API: "gl"}}},
+		{Extensions: []Extension{{Name: "GL_ARB_clear_texture"}}},
+	}
+	for _, conflict := range conflicts {
+		if _, err := Merge(parseTest(t), conflict); err == nil {
+			t.Errorf("No error merging conflicting registry %+v", conflict)
+		}
+	}
+}
@@ -10,7 +10,7 @@ import (
 
 type xRegistry struct {
 	Types      []xType      `xml:"types>type"`
-	Enums      []xEnum      `xml:"enums>enum"`
+	Enums      []xEnums     `xml:"enums"`
 	Commands   []xCommand   `xml:"commands>command"`
 	Features   []xFeature   `xml:"feature"`
 	Extensions []xExtension `xml:"extensions>extension"`
@@ -20,25 +20,46 @@ type xType struct {
 	Name  xString `xml:"name"`
 	NameA string  `xml:"name,attr"`
 }
+type xEnums struct {
+	Namespace string  `xml:"namespace,attr"`
+	Group     string  `xml:"group,attr"`
+	Type      string  `xml:"type,attr"`
+	Vendor    string  `xml:"vendor,attr"`
+	Enums     []xEnum `xml:"enum"`
+}
 type xEnum struct {
-	API   string `xml:"api,attr"`
-	Name  string `xml:"name,attr"`
-	Type  string `xml:"group,attr"`
-	Value string `xml:"value,attr"`
+	API       string `xml:"api,attr"`
+	Name      string `xml:"name,attr"`
+	Type      string `xml:"group,attr"`
+	Value     string `xml:"value,attr"`
+	ValueType string `xml:"type,attr"`
+	Alias     string `xml:"alias,attr"`
+	Comment   string `xml:"comment,attr"`
 }
 type xCommand struct {
-	Proto  xParam   `xml:"proto"`
-	Params []xParam `xml:"param"`
+	Comment string   `xml:"comment,attr"`
+	Proto   xParam   `xml:"proto"`
+	Params  []xParam `xml:"param"`
+	Alias   xFeatCmd `xml:"alias"`
 }
 type xParam struct {
 	Name  xString `xml:"name"`
 	Group string  `xml:"group,attr"`
+	Len   string  `xml:"len,attr"`
+	Class string  `xml:"class,attr"`
 	Raw   []byte  `xml:",innerxml"`
 }
 type xFeature struct {
 	API      string     `xml:"api,attr"`
+	Name     string     `xml:"name,attr"`
 	Number   float64    `xml:"number,attr"`
 	Commands []xFeatCmd `xml:"require>command"`
+	Enums    []xFeatCmd `xml:"require>enum"`
+	Removes  []xRemove  `xml:"remove"`
+}
+type xRemove struct {
+	Profile  string     `xml:"profile,attr"`
+	Commands []xFeatCmd `xml:"command"`
 }
 type xFeatCmd struct {
 	Name string `xml:"name,attr"`
@@ -47,12 +68,14 @@ type xExtension struct {
 	Name      string     `xml:"name,attr"`
 	Supported string     `xml:"supported,attr"`
 	Commands  []xFeatCmd `xml:"require>command"`
+	Enums     []xFeatCmd `xml:"require>enum"`
 }
 type xString struct {
 	S string `xml:",chardata"`
 }
 
-func (par xParam) Type() (string, error) {
+// Decl returns the declared C type of the parameter, with its whitespace normalized
+func (par xParam) Decl() (string, error) {
 	r := bytes.NewReader(par.Raw)
 	b := strings.Builder{}
 	dec := xml.NewDecoder(r)
@@ -74,13 +97,31 @@ loop:
 		}
 	}
 
-	ty := b.String()
+	return strings.Join(strings.Fields(b.String()), " "), nil
+}
+
+// Type returns the C type of the parameter without qualifiers
+func (par xParam) Type() (string, error) {
+	ty, err := par.Decl()
 	ty = strings.ReplaceAll(ty, "const", "")
 	ty = strings.Trim(ty, " \t\n")
-	return ty, nil
+	return ty, err
 }
 
+// Parse reads a registry in the Khronos XML format.
+// Only the enums, features and extensions for OpenGL are included; use ParseAll for other APIs.
 func Parse(r io.Reader) (*Registry, error) {
+	return parse(r, "gl")
+}
+
+// ParseAll is Parse, but includes the enums, features and extensions of every API, and a Generator selects the ones to generate.
+// An enum whose value differs between APIs appears once for each API.
+func ParseAll(r io.Reader) (*Registry, error) {
+	return parse(r, "")
+}
+
+// parse reads a registry, keeping only the parts for api unless it is empty
+func parse(r io.Reader, api string) (*Registry, error) {
 	// Parse XML
 	dec := xml.NewDecoder(r)
 	var xreg xRegistry
@@ -91,7 +132,7 @@ func Parse(r io.Reader) (*Registry, error) {
 	// Convert registry
 	reg := &Registry{
 		Types:      make(map[string]Type, len(xreg.Types)),
-		Enums:      make([]Enum, 0, len(xreg.Enums)),
+		Enums:      []Enum{},
 		Commands:   make([]Command, len(xreg.Commands)),
 		Features:   make([]Feature, 0, len(xreg.Features)),
 		Extensions: make([]Extension, 0, len(xreg.Extensions)),
@@ -110,8 +151,19 @@ func Parse(r io.Reader) (*Registry, error) {
 			switch name {
 			case "GLvoid":
 				continue // Ignore void, it's unused
-			case "GLeglClientBufferEXT", "GLeglImageOES", "struct _cl_context", "struct _cl_event", "GLVULKANPROCNV":
-				ty = UnsupportedType
+			case "GLeglImageOES":
+				ty = GLeglImageOES
+			case "GLeglClientBufferEXT":
+				ty = GLeglClientBufferEXT
+			case "_cl_context", "struct _cl_context":
+				// Commands refer to the struct type, not its tag
+				name = "struct _cl_context"
+				ty = CLContext
+			case "_cl_event", "struct _cl_event":
+				name = "struct _cl_event"
+				ty = CLEvent
+			case "GLVULKANPROCNV":
+				ty = GLVULKANPROCNV
 			case "GLDEBUGPROC", "GLDEBUGPROCARB", "GLDEBUGPROCKHR", "GLDEBUGPROCAMD":
 				ty = GLDEBUGPROC
 			case "GLboolean":
@@ -130,9 +182,24 @@ func Parse(r io.Reader) (*Registry, error) {
 		reg.Types[name] = ty
 	}
 
-	for _, xenum := range xreg.Enums {
-		if xenum.API == "" || xenum.API == "gl" {
-			reg.Enums = append(reg.Enums, Enum{xenum.Name, xenum.Type, xenum.Value})
+	for _, xenums := range xreg.Enums {
+		for _, xenum := range xenums.Enums {
+			if api != "" && xenum.API != "" && xenum.API != api {
+				continue
+			}
+			reg.Enums = append(reg.Enums, Enum{
+				xenum.Name,
+				xenum.Type,
+				xenum.Value,
+				xenum.ValueType,
+				xenum.Alias,
+				xenum.Comment,
+				xenums.Type == "bitmask",
+				xenums.Namespace,
+				xenums.Vendor,
+				xenum.API,
+				xenums.Group,
+			})
 		}
 	}
 
@@ -145,46 +212,53 @@ func Parse(r io.Reader) (*Registry, error) {
 			xcmd.Proto.Name.S,
 			make([]Param, len(xcmd.Params)),
 			ty,
+			xcmd.Proto.Class,
+			xcmd.Proto.Group,
+			xcmd.Alias.Name,
+			xcmd.Comment,
 		}
 		for j, xpar := range xcmd.Params {
 			ty, err := xpar.Type()
 			if err != nil {
 				return nil, err
 			}
-			cmd.Params[j] = Param{xpar.Name.S, ty}
+			decl, _ := xpar.Decl()
+			cmd.Params[j] = Param{xpar.Name.S, ty, xpar.Group, xpar.Len, xpar.Class, decl}
 		}
 		reg.Commands[i] = cmd
 	}
 
 	for _, xfeat := range xreg.Features {
-		if xfeat.API != "gl" {
+		if api != "" && xfeat.API != api {
 			continue
 		}
-
 		feat := Feature{
 			int(xfeat.Number*100 + 0.5),
 			make([]string, len(xfeat.Commands)),
+			xfeat.Name,
+			xfeat.API,
+			nil,
+			names(xfeat.Enums),
 		}
 		for i, cmd := range xfeat.Commands {
 			feat.Commands[i] = cmd.Name
 		}
+		for _, xrem := range xfeat.Removes {
+			rem := Removal{xrem.Profile, make([]string, len(xrem.Commands))}
+			for i, cmd := range xrem.Commands {
+				rem.Commands[i] = cmd.Name
+			}
+			feat.Removed = append(feat.Removed, rem)
+		}
 		reg.Features = append(reg.Features, feat)
 	}
 
 	for _, xext := range xreg.Extensions {
 		support := strings.Split(xext.Supported, "|")
-		glSupported := false
-		for _, s := range support {
-			if s == "gl" {
-				glSupported = true
-				break
-			}
-		}
-		if !glSupported {
+		if api != "" && !contains(support, api) {
 			continue
 		}
-
-		ext := Extension{make([]string, len(xext.Commands))}
+		ext := Extension{make([]string, len(xext.Commands)), xext.Name, support, names(xext.Enums)}
 		for i, cmd := range xext.Commands {
 			ext.Commands[i] = cmd.Name
 		}
@@ -194,6 +268,14 @@ func Parse(r io.Reader) (*Registry, error) {
 	return reg, nil
 }
 
+func names(xnames []xFeatCmd) []string {
+	names := make([]string, len(xnames))
+	for i, x := range xnames {
+		names[i] = x.Name
+	}
+	return names
+}
+
 func parseTypeDef(tdef string) Type {
 	if !strings.HasPrefix(tdef, "typedef ") || !strings.HasSuffix(tdef, " ;") {
 		return InvalidType
@@ -3,6 +3,7 @@ package gen
 import (
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -20,22 +21,47 @@ func TestParse(t *testing.T) {
 		Types: map[string]Type{
 			"GLenum":     Uint32,
 			"GLbitfield": Uint32,
+			"GLuint":     Uint32,
+			"GLsizei":    Int32,
+
+			"struct _cl_context": CLContext,
+			"GLeglImageOES":      GLeglImageOES,
 		},
 		Enums: []Enum{
-			{"GL_CLIENT_PIXEL_STORE_BIT", "ClientAttribMask", "0x00000001"},
-			{"GL_CLIENT_VERTEX_ARRAY_BIT", "ClientAttribMask", "0x00000002"},
-			{"GL_CLIENT_ALL_ATTRIB_BITS", "ClientAttribMask", "0xFFFFFFFF"},
+			{"GL_CLIENT_PIXEL_STORE_BIT", "ClientAttribMask", "0x00000001", "", "", "", true, "GL", "", "", "ClientAttribMask"},
+			{"GL_CLIENT_VERTEX_ARRAY_BIT", "ClientAttribMask", "0x00000002", "", "", "", true, "GL", "", "", "ClientAttribMask"},
+			{"GL_CLIENT_ALL_ATTRIB_BITS", "ClientAttribMask", "0xFFFFFFFF", "", "", "", true, "GL", "", "", "ClientAttribMask"},
+			{"GL_DRAW_FRAMEBUFFER_BINDING", "GetPName", "0x8CA6", "", "", "", false, "GL", "ARB", "", ""},
+			{"GL_DRAW_FRAMEBUFFER_BINDING_EXT", "", "0x8CA6", "", "GL_DRAW_FRAMEBUFFER_BINDING", "", false, "GL", "ARB", "", ""},
+			{"GL_FRAMEBUFFER_BINDING", "", "0x8CA6", "", "", "Same as GL_DRAW_FRAMEBUFFER_BINDING", false, "GL", "ARB", "", ""},
+			{"GL_ACTIVE_PROGRAM_EXT", "", "0x8B8D", "", "", "For the OpenGL version of EXT_separate_shader_objects", false, "GL", "ARB", "gl", ""},
+			{"GL_INVALID_INDEX", "", "0xFFFFFFFF", "u", "", "", false, "GL", "ARB", "", "SpecialNumbers"},
+			{"GL_TIMEOUT_IGNORED", "", "0xFFFFFFFFFFFFFFFF", "ull", "", "", false, "GL", "ARB", "", "SpecialNumbers"},
+			{"GL_TIMEOUT_IGNORED_APPLE", "", "0xFFFFFFFFFFFFFFFF", "ull", "GL_TIMEOUT_IGNORED", "", false, "GL", "ARB", "", "SpecialNumbers"},
 		},
 		Commands: []Command{
 			{"glClientAttribDefaultEXT", []Param{
-				{"mask", "GLbitfield"},
-			}, "void"},
+				{"mask", "GLbitfield", "ClientAttribMask", "", "", "GLbitfield"},
+			}, "void", "", "", "", ""},
+			{"glDeleteBuffers", []Param{
+				{"n", "GLsizei", "", "", "", "GLsizei"},
+				{"buffers", "GLuint *", "", "n", "buffer", "const GLuint *"},
+			}, "void", "", "", "", "Also used for GL_ARB_vertex_buffer_object"},
+			{"glDeleteBuffersARB", []Param{
+				{"n", "GLsizei", "", "", "", "GLsizei"},
+				{"buffers", "GLuint *", "", "n", "buffer", "const GLuint *"},
+			}, "void", "", "", "glDeleteBuffers", ""},
+			{"glCreateProgram", []Param{}, "GLuint", "program", "", "", ""},
 		},
 		Features: []Feature{
-			{430, []string{"glDispatchCompute", "glDispatchComputeIndirect"}},
+			{320, []string{"glCreateProgram"}, "GL_VERSION_3_2", "gl", []Removal{
+				{"core", []string{"glClientAttribDefaultEXT"}},
+			}, []string{}},
+			{430, []string{"glDispatchCompute", "glDispatchComputeIndirect"}, "GL_VERSION_4_3", "gl", nil, []string{}},
 		},
 		Extensions: []Extension{
-			{[]string{"glClearTexImage", "glClearTexSubImage"}},
+			{[]string{"glClearTexImage", "glClearTexSubImage"}, "GL_ARB_clear_texture", []string{"gl", "glcore"}, []string{"GL_CLEAR_TEXTURE"}},
+			{[]string{"glClientAttribDefaultEXT"}, "GL_EXT_direct_state_access", []string{"gl"}, []string{}},
 		},
 	}
 
@@ -43,7 +69,7 @@ func TestParse(t *testing.T) {
 		t.Errorf("Types do not match:\n\t%q\n\t%q", expected.Types, reg.Types)
 	}
 	if !reflect.DeepEqual(expected.Enums, reg.Enums) {
-		t.Errorf("Enums do not match:\n\t%q\n\t%q", expected.Enums, reg.Enums)
+		t.Errorf("Enums do not match:\n\t%+v\n\t%+v", expected.Enums, reg.Enums)
 	}
 	if !reflect.DeepEqual(expected.Commands, reg.Commands) {
 		t.Errorf("Commands do not match:\n\t%q\n\t%q", expected.Commands, reg.Commands)
@@ -55,3 +81,59 @@ func TestParse(t *testing.T) {
 		t.Errorf("Extensions do not match:\n\t%q\n\t%q", expected.Extensions, reg.Extensions)
 	}
 }
+
+func TestParseAll(t *testing.T) {
+	f, err := os.Open("testdata/test.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	reg, err := ParseAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var values []string
+	for _, enum := range reg.Enums {
+		if enum.Name == "GL_ACTIVE_PROGRAM_EXT" {
+			values = append(values, enum.API+" "+enum.Value)
+		}
+	}
+	if expected := []string{"gl 0x8B8D", "gles2 0x8259"}; !reflect.DeepEqual(values, expected) {
+		t.Errorf("Expected GL_ACTIVE_PROGRAM_EXT values %v, got %v", expected, values)
+	}
+	var features []string
+	for _, feat := range reg.Features {
+		features = append(features, feat.Name)
+	}
+	if expected := []string{"GL_VERSION_3_2", "GL_VERSION_4_3", "GL_ES_VERSION_2_0"}; !reflect.DeepEqual(features, expected) {
+		t.Errorf("Expected features %v, got %v", expected, features)
+	}
+}
+
+func TestParseDecl(t *testing.T) {
+	const src = `<registry><commands namespace="GL">
+	<command>
+		<proto group="ErrorCode"><ptype>GLenum</ptype> <name>glGetError</name></proto>
+	</command>
+	<command>
+		<proto>void <name>glShaderSource</name></proto>
+		<param len="count">const <ptype>GLchar</ptype> *const*<name>string</name></param>
+		<param>const <ptype>GLchar</ptype> *<name>name</name></param>
+	</command>
+</commands></registry>`
+	reg, err := Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if group := reg.Commands[0].ReturnGroup; group != "ErrorCode" {
+		t.Errorf("Incorrect return group: %q", group)
+	}
+	expected := []Param{
+		{"string", "GLchar **", "", "count", "", "const GLchar *const*"},
+		{"name", "GLchar *", "", "", "", "const GLchar *"},
+	}
+	if !reflect.DeepEqual(reg.Commands[1].Params, expected) {
+		t.Errorf("Params do not match:\n\t%q\n\t%q", expected, reg.Commands[1].Params)
+	}
+}
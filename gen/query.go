@@ -0,0 +1,66 @@
+package gen
+
+import "strings"
+
+// Command returns the named command. The gl prefix may be omitted.
+func (reg *Registry) Command(name string) (cmd Command, ok bool) {
+	if !strings.HasPrefix(name, "gl") {
+		name = "gl" + name
+	}
+	for _, cmd := range reg.Commands {
+		if cmd.Name == name {
+			return cmd, true
+		}
+	}
+	return Command{}, false
+}
+
+// Aliases returns the commands that are aliases of the named command
+func (reg *Registry) Aliases(name string) []string {
+	var aliases []string
+	for _, cmd := range reg.Commands {
+		if cmd.Alias == name {
+			aliases = append(aliases, cmd.Name)
+		}
+	}
+	return aliases
+}
+
+// Requiring returns the features and extensions that require the named command
+func (reg *Registry) Requiring(name string) (features []Feature, exts []Extension) {
+	for _, feat := range reg.Features {
+		if contains(feat.Commands, name) {
+			features = append(features, feat)
+		}
+	}
+	for _, ext := range reg.Extensions {
+		if contains(ext.Commands, name) {
+			exts = append(exts, ext)
+		}
+	}
+	return features, exts
+}
+
+// NewFeatures returns the features of api after version from, up to and including version to.
+// The Commands of each feature returned only include those not required by an earlier version.
+func (reg *Registry) NewFeatures(api string, from, to int) []Feature {
+	var features []Feature
+	seen := make(map[string]bool)
+	for _, feat := range reg.Features {
+		if feat.API != api || feat.Version > to {
+			continue
+		}
+		added := []string{}
+		for _, cmd := range feat.Commands {
+			if !seen[cmd] {
+				added = append(added, cmd)
+			}
+			seen[cmd] = true
+		}
+		if feat.Version > from {
+			feat.Commands = added
+			features = append(features, feat)
+		}
+	}
+	return features
+}
@@ -0,0 +1,35 @@
+package gen
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	reg := parseTestWith(t, ParseAll)
+
+	cmd, ok := reg.Command("DeleteBuffersARB")
+	if !ok || cmd.Name != "glDeleteBuffersARB" {
+		t.Fatalf("DeleteBuffersARB not found: %v", cmd)
+	}
+	if _, ok := reg.Command("glBogus"); ok {
+		t.Error("Found nonexistent command")
+	}
+	if aliases := reg.Aliases("glDeleteBuffers"); !reflect.DeepEqual(aliases, []string{"glDeleteBuffersARB"}) {
+		t.Errorf("Incorrect aliases: %v", aliases)
+	}
+
+	features, exts := reg.Requiring("glClientAttribDefaultEXT")
+	if len(features) != 0 || len(exts) != 1 || exts[0].Name != "GL_EXT_direct_state_access" {
+		t.Errorf("Incorrect features and extensions requiring glClientAttribDefaultEXT: %v %v", features, exts)
+	}
+
+	features = reg.NewFeatures("gl", 320, 430)
+	if len(features) != 1 || features[0].Name != "GL_VERSION_4_3" ||
+		!reflect.DeepEqual(features[0].Commands, []string{"glDispatchCompute", "glDispatchComputeIndirect"}) {
+		t.Errorf("Incorrect features added from 3.2 to 4.3: %v", features)
+	}
+	if features := reg.NewFeatures("gles2", 0, 300); len(features) != 1 || features[0].Name != "GL_ES_VERSION_2_0" {
+		t.Errorf("Incorrect GLES features: %v", features)
+	}
+}
@@ -1,5 +1,7 @@
 package gen
 
+import "strconv"
+
 type Registry struct {
 	Types      map[string]Type
 	Enums      []Enum
@@ -38,30 +40,110 @@ const (
 	GLsync
 	GLDEBUGPROC
 
-	// TODO: _cl_context, _cl_event, GLVULKANPROCNV
+	GLeglImageOES
+	GLeglClientBufferEXT
+	CLContext
+	CLEvent
+	GLVULKANPROCNV
 )
 
+var typeNames = [...]string{
+	InvalidType:     "invalid",
+	UnsupportedType: "unsupported",
+
+	Int8:    "int8",
+	Int16:   "int16",
+	Int32:   "int32",
+	Int64:   "int64",
+	Intptr:  "intptr",
+	Intsize: "intsize",
+
+	Uint8:    "uint8",
+	Uint16:   "uint16",
+	Uint32:   "uint32",
+	Uint64:   "uint64",
+	Uintptr:  "uintptr",
+	Uintsize: "uintsize",
+
+	Float32: "float32",
+	Float64: "float64",
+
+	Bool:    "bool",
+	Pointer: "pointer",
+
+	GLhandleARB: "GLhandleARB",
+	GLsync:      "GLsync",
+	GLDEBUGPROC: "GLDEBUGPROC",
+
+	GLeglImageOES:        "GLeglImageOES",
+	GLeglClientBufferEXT: "GLeglClientBufferEXT",
+	CLContext:            "struct _cl_context",
+	CLEvent:              "struct _cl_event",
+	GLVULKANPROCNV:       "GLVULKANPROCNV",
+}
+
+func (t Type) String() string {
+	if t < 0 || int(t) >= len(typeNames) {
+		return "Type(" + strconv.Itoa(int(t)) + ")"
+	}
+	return typeNames[t]
+}
+
+// MarshalText encodes the type as its name, so registries can be encoded as JSON
+func (t Type) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
 type Enum struct {
-	Name  string
-	Type  string
-	Value string
+	Name      string
+	Type      string // The enum's groups, separated by commas
+	Value     string
+	ValueType string // The type suffix of the value in C: empty for GLenum, u for GLuint or ull for GLuint64
+	Alias     string
+	Comment   string
+	Bitmask   bool // Whether the enum is in a block of bitmask values
+	Namespace string
+	Vendor    string
+	API       string // The API the value is for, eg. gles2, or empty if it is the same in every API
+	Group     string // The group of the block of enums the enum is in, if any
 }
 
 type Command struct {
-	Name   string
-	Params []Param
-	Return string
+	Name        string
+	Params      []Param
+	Return      string
+	ReturnClass string
+	ReturnGroup string // The enum group of the return value, if any
+	Alias       string // The command this command is an alias of, if any
+	Comment     string
 }
 type Param struct {
-	Name string
-	Type string
+	Name  string
+	Type  string
+	Group string
+	Len   string
+	Class string
+	Decl  string // The C type as declared, including qualifiers, eg. "const GLchar *"
 }
 
 type Feature struct {
 	Version  int
 	Commands []string
+	Name     string // eg. GL_VERSION_4_3
+	API      string // eg. gl or gles2
+	Removed  []Removal
+	Enums    []string
 }
 
-type Extension struct {
+// Removal is a set of commands removed from an API by a Feature
+type Removal struct {
+	Profile  string // The profile the commands are removed from, eg. core, or empty for every profile
 	Commands []string
 }
+
+type Extension struct {
+	Commands  []string
+	Name      string   // eg. GL_ARB_clear_texture
+	Supported []string // The APIs the extension is supported by, eg. gl and glcore
+	Enums     []string
+}
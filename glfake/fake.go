@@ -0,0 +1,311 @@
+// Package glfake provides a fake GL for testing code that uses gll without a GPU or GL context.
+//
+// A GL implements every gll interface in pure Go. It records every call, allocates object names for commands that
+// create objects, and returns zero values from every other command unless told otherwise with Handle, Return or SetQuery.
+package glfake
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"unsafe"
+
+	"github.com/vktec/gll"
+)
+
+// GL is a fake GL that records the commands it executes
+type GL struct {
+	calls    []Call
+	handlers map[gll.CommandID]Handler
+	queries  map[gll.CommandID]map[uint32]reflect.Value
+	names    map[string]uint32
+}
+
+var _ gll.GL460 = (*GL)(nil)
+
+// Handler implements a command. It is called with the arguments of each call to the command, and returns the return value,
+// or nil for the zero value.
+type Handler func(args []gll.Arg) gll.Arg
+
+// Call is a command executed by a GL
+type Call struct {
+	Command gll.CommandID
+	Args    []gll.Arg
+	Return  gll.Arg // The return value, or nil if the command returns nothing
+
+	text string
+}
+
+// String formats the call in the form
+//
+//	glBindBuffer(GL_ARRAY_BUFFER, 1)
+//
+// Enums are decoded and arrays are printed with their contents as they were after the call.
+func (c Call) String() string {
+	return c.text
+}
+
+// New returns a fake GL. Framebuffers are complete, and shaders and programs compile and link successfully.
+func New() *GL {
+	gl := &GL{
+		handlers: make(map[gll.CommandID]Handler),
+		queries:  make(map[gll.CommandID]map[uint32]reflect.Value),
+		names:    make(map[string]uint32),
+	}
+	gl.Return(gll.CmdCheckFramebufferStatus, uint32(gll.FRAMEBUFFER_COMPLETE))
+	gl.Return(gll.CmdCheckNamedFramebufferStatus, uint32(gll.FRAMEBUFFER_COMPLETE))
+	gl.SetQuery(gll.CmdGetShaderiv, gll.COMPILE_STATUS, []int32{gll.TRUE})
+	gl.SetQuery(gll.CmdGetProgramiv, gll.LINK_STATUS, []int32{gll.TRUE})
+	gl.SetQuery(gll.CmdGetProgramiv, gll.VALIDATE_STATUS, []int32{gll.TRUE})
+	return gl
+}
+
+// Calls returns every call recorded since the GL was created or reset
+func (gl *GL) Calls() []Call {
+	return gl.calls
+}
+
+// Log returns the recorded calls formatted as by Call.String.
+// If prefixes are given, only commands whose names start with one of them are included, eg. "glDraw".
+func (gl *GL) Log(prefixes ...string) []string {
+	log := []string{}
+calls:
+	for _, c := range gl.calls {
+		if len(prefixes) > 0 {
+			name := c.Command.String()
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(name, prefix) {
+					log = append(log, c.text)
+					continue calls
+				}
+			}
+			continue
+		}
+		log = append(log, c.text)
+	}
+	return log
+}
+
+// Reset discards the recorded calls. Object names, handlers and queries are kept.
+func (gl *GL) Reset() {
+	gl.calls = nil
+}
+
+// Handle makes h implement cmd, replacing any previous handler or return value
+func (gl *GL) Handle(cmd gll.CommandID, h Handler) {
+	gl.handlers[cmd] = h
+}
+
+// Return makes every call to cmd return ret, whose type must be the command's return type
+func (gl *GL) Return(cmd gll.CommandID, ret gll.Arg) {
+	gl.Handle(cmd, func(args []gll.Arg) gll.Arg {
+		return ret
+	})
+}
+
+// SetQuery sets the values written by a query command, such as GetIntegerv or GetShaderiv, when it is called with pname.
+// The query's parameter name must be its second to last parameter, and its output array its last.
+// values must be a slice of the output's element type, eg. []int32 for GetIntegerv.
+func (gl *GL) SetQuery(cmd gll.CommandID, pname uint32, values interface{}) {
+	v := reflect.ValueOf(values)
+	if v.Kind() != reflect.Slice {
+		panic("glfake: query values must be a slice")
+	}
+	if gl.queries[cmd] == nil {
+		gl.queries[cmd] = make(map[uint32]reflect.Value)
+	}
+	gl.queries[cmd][pname] = v
+}
+
+func (gl *GL) call(cmd gll.CommandID, args ...gll.Arg) gll.Arg {
+	var ret gll.Arg
+	if h, ok := gl.handlers[cmd]; ok {
+		ret = h(args)
+	} else if len(args) >= 2 {
+		if pname, ok := args[len(args)-2].(uint32); ok {
+			gl.query(cmd, pname, args[len(args)-1])
+		}
+	}
+	if ret == nil {
+		ret = gl.create(cmd, args)
+	}
+	gl.calls = append(gl.calls, Call{cmd, args, ret, format(cmd, args, ret)})
+	return ret
+}
+
+func (gl *GL) query(cmd gll.CommandID, pname uint32, out gll.Arg) {
+	values, ok := gl.queries[cmd][pname]
+	dst := reflect.ValueOf(out)
+	if !ok || dst.Kind() != reflect.Ptr || dst.IsNil() {
+		return
+	}
+	if dst.Type().Elem() != values.Type().Elem() {
+		panic(fmt.Sprintf("glfake: %s writes %s, but query values are %s", cmd, dst.Type(), values.Type()))
+	}
+	arr := reflect.NewAt(reflect.ArrayOf(values.Len(), values.Type().Elem()), unsafe.Pointer(dst.Pointer())).Elem()
+	reflect.Copy(arr, values)
+}
+
+// create allocates names for commands that create objects, returning the name if the command returns it.
+// Each kind of object has its own names, starting at 1.
+func (gl *GL) create(cmd gll.CommandID, args []gll.Arg) gll.Arg {
+	info := cmd.Info()
+	var kind string
+	if strings.HasPrefix(info.Name, "glGen") {
+		kind = strings.TrimPrefix(info.Name, "glGen")
+	} else if strings.HasPrefix(info.Name, "glCreate") {
+		kind = strings.TrimPrefix(info.Name, "glCreate")
+	} else {
+		return nil
+	}
+
+	// Names are uint32 or one of the handle types, eg. gll.Buffer
+	if info.Return != "" && gll.ArgType(info.Return).Kind() == reflect.Uint32 {
+		if kind == "Shader" || strings.HasPrefix(kind, "ShaderProgram") {
+			// Shaders and programs share names
+			kind = "Program"
+		}
+		n := uint32(1)
+		if cmd == gll.CmdGenLists {
+			// GenLists allocates range consecutive names and returns the first, or 0 if range is not positive
+			range_, _ := args[0].(int32)
+			if range_ <= 0 {
+				return uint32(0)
+			}
+			n = uint32(range_)
+		}
+		first := gl.names[kind] + 1
+		gl.names[kind] += n
+		return reflect.ValueOf(first).Convert(gll.ArgType(info.Return)).Interface()
+	}
+	if len(args) < 2 {
+		return nil
+	}
+	n, ok := args[len(args)-2].(int32)
+	names := reflect.ValueOf(args[len(args)-1])
+	if !ok || names.Kind() != reflect.Ptr || names.Type().Elem().Kind() != reflect.Uint32 || names.IsNil() {
+		return nil
+	}
+	for _, p := range elems(names.Interface(), int(n)) {
+		gl.names[kind]++
+		reflect.ValueOf(p).Elem().SetUint(uint64(gl.names[kind]))
+	}
+	return nil
+}
+
+// elems returns pointers to the n elements of the array starting at ptr
+func elems(ptr gll.Arg, n int) []gll.Arg {
+	v := reflect.ValueOf(ptr)
+	if n <= 0 || v.IsNil() {
+		return nil
+	}
+	arr := reflect.NewAt(reflect.ArrayOf(n, v.Type().Elem()), unsafe.Pointer(v.Pointer())).Elem()
+	ptrs := make([]gll.Arg, n)
+	for i := range ptrs {
+		ptrs[i] = arr.Index(i).Addr().Interface()
+	}
+	return ptrs
+}
+
+func format(cmd gll.CommandID, args []gll.Arg, ret gll.Arg) string {
+	info := cmd.Info()
+	argS := make([]string, len(args))
+	for i := range args {
+		argS[i] = formatArg(info, args, i)
+	}
+	s := info.Name + "(" + strings.Join(argS, ", ") + ")"
+	if info.Return != "" {
+		s += fmt.Sprintf(" = %v", ret)
+	}
+	return s
+}
+
+func formatArg(info *gll.CommandInfo, args []gll.Arg, i int) string {
+	par := info.Params[i]
+	switch arg := args[i].(type) {
+	case uint32:
+		if name := gll.EnumName(par.Group, arg); name != "" {
+			return name
+		}
+	case unsafe.Pointer:
+		if arg == nil {
+			return "NULL"
+		}
+		return fmt.Sprintf("%p", arg)
+	}
+
+	v := reflect.ValueOf(args[i])
+	if v.Kind() != reflect.Ptr {
+		return fmt.Sprint(args[i])
+	}
+	if v.IsNil() {
+		return "NULL"
+	}
+	if par.CString() {
+		return fmt.Sprintf("%q", gll.GoStr(args[i].(*uint8)))
+	}
+	n, ok := info.ArgLen(args, i)
+	if !ok && i > 0 {
+		// Without a len in the registry, assume arrays follow their element count
+		if prev := info.Params[i-1].Name; prev == "n" || prev == "count" {
+			if count, isInt := args[i-1].(int32); isInt {
+				n, ok = int(count), true
+			}
+		}
+	}
+	if !ok || v.Type().Elem().Kind() == reflect.Ptr {
+		return fmt.Sprintf("%p", args[i])
+	}
+	elemS := make([]string, 0, n)
+	for _, p := range elems(args[i], n) {
+		elemS = append(elemS, fmt.Sprint(reflect.ValueOf(p).Elem()))
+	}
+	return "[" + strings.Join(elemS, " ") + "]"
+}
+
+// Expect fails the test unless the recorded calls, formatted as by Call.String, are exactly calls.
+// An argument of _ in an expected call matches any value.
+func (gl *GL) Expect(t testing.TB, calls ...string) {
+	t.Helper()
+	log := gl.Log()
+	for i := 0; i < len(log) || i < len(calls); i++ {
+		switch {
+		case i >= len(calls):
+			t.Errorf("Unexpected call %d: %s", i, log[i])
+		case i >= len(log):
+			t.Errorf("Missing call %d: %s", i, calls[i])
+		case !matchCall(calls[i], log[i]):
+			t.Errorf("Call %d: expected %s, got %s", i, calls[i], log[i])
+		}
+	}
+}
+
+func matchCall(expected, actual string) bool {
+	if expected == actual {
+		return true
+	}
+	eName, eArgs, eRet := splitCall(expected)
+	aName, aArgs, aRet := splitCall(actual)
+	if eName != aName || eRet != aRet || len(eArgs) != len(aArgs) {
+		return false
+	}
+	for i := range eArgs {
+		if eArgs[i] != "_" && eArgs[i] != aArgs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func splitCall(call string) (name string, args []string, ret string) {
+	open := strings.IndexByte(call, '(')
+	close := strings.LastIndexByte(call, ')')
+	if open < 0 || close < open {
+		return call, nil, ""
+	}
+	if argS := call[open+1 : close]; argS != "" {
+		args = strings.Split(argS, ", ")
+	}
+	return call[:open], args, call[close+1:]
+}
@@ -0,0 +1,78 @@
+package glfake
+
+import (
+	"testing"
+
+	"github.com/vktec/gll"
+	"github.com/vktec/gll/glh"
+)
+
+func TestRecord(t *testing.T) {
+	gl := New()
+	var buffers [2]gll.Buffer
+	gl.GenBuffers(2, &buffers[0])
+	if buffers != [2]gll.Buffer{1, 2} {
+		t.Errorf("Expected buffers 1 and 2, got %v", buffers)
+	}
+	gl.BindBuffer(gll.ARRAY_BUFFER, buffers[1])
+	data := []byte{1, 2, 3, 4}
+	gl.BufferData(gll.ARRAY_BUFFER, len(data), gll.Ptr(data), gll.STATIC_DRAW)
+	prog := gl.CreateProgram()
+	gl.DeleteBuffers(1, &buffers[0])
+
+	gl.Expect(t,
+		"glGenBuffers(2, [1 2])",
+		"glBindBuffer(GL_ARRAY_BUFFER, 2)",
+		"glBufferData(GL_ARRAY_BUFFER, 4, _, GL_STATIC_DRAW)",
+		"glCreateProgram() = 1",
+		"glDeleteBuffers(1, [1])",
+	)
+	if prog != 1 {
+		t.Errorf("Expected program 1, got %d", prog)
+	}
+	if log := gl.Log("glBind"); len(log) != 1 || log[0] != "glBindBuffer(GL_ARRAY_BUFFER, 2)" {
+		t.Errorf("Incorrect filtered log: %v", log)
+	}
+	if calls := gl.Calls(); calls[2].Args[2] != gll.Ptr(data) {
+		t.Errorf("Incorrect data pointer recorded: %v", calls[2].Args[2])
+	}
+}
+
+func TestScript(t *testing.T) {
+	gl := New()
+	gl.SetQuery(gll.CmdGetIntegerv, gll.MAX_TEXTURE_SIZE, []int32{4096})
+	gl.Return(gll.CmdGetError, uint32(gll.OUT_OF_MEMORY))
+	gl.Handle(gll.CmdIsEnabled, func(args []gll.Arg) gll.Arg {
+		return args[0].(uint32) == gll.BLEND
+	})
+
+	var size int32
+	gl.GetIntegerv(gll.MAX_TEXTURE_SIZE, &size)
+	if size != 4096 {
+		t.Errorf("Expected max texture size 4096, got %d", size)
+	}
+	if err := gl.GetError(); err != gll.OUT_OF_MEMORY {
+		t.Errorf("Expected GL_OUT_OF_MEMORY, got %#x", err)
+	}
+	if !gl.IsEnabled(gll.BLEND) || gl.IsEnabled(gll.DEPTH_TEST) {
+		t.Error("Incorrect IsEnabled results")
+	}
+
+	// The default queries allow shaders to compile
+	if _, err := glh.NewShader(gl, gll.VERTEX_SHADER, "void main() {}\x00"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGenLists(t *testing.T) {
+	gl := New()
+	if first := gl.GenLists(3); first != 1 {
+		t.Errorf("Expected lists 1 to 3, got %d", first)
+	}
+	if first := gl.GenLists(2); first != 4 {
+		t.Errorf("Expected lists 4 and 5, got %d", first)
+	}
+	if first := gl.GenLists(0); first != 0 {
+		t.Errorf("Expected no lists, got %d", first)
+	}
+}
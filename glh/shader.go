@@ -7,7 +7,7 @@ import (
 	"github.com/vktec/gll"
 )
 
-func NewShader(gl gll.GL200, shaderType uint32, shaderSources ...string) (shad uint32, err error) {
+func NewShader(gl gll.GL200, shaderType uint32, shaderSources ...string) (shad gll.Shader, err error) {
 	shad = gl.CreateShader(shaderType)
 	csrc, clen, free := gll.Strs(shaderSources...)
 	gl.ShaderSource(shad, int32(len(shaderSources)), csrc, clen)
@@ -27,7 +27,7 @@ func NewShader(gl gll.GL200, shaderType uint32, shaderSources ...string) (shad u
 	return shad, nil
 }
 
-func NewProgram(gl gll.GL200, shaders ...uint32) (prog uint32, err error) {
+func NewProgram(gl gll.GL200, shaders ...gll.Shader) (prog gll.Program, err error) {
 	prog = gl.CreateProgram()
 	for _, shad := range shaders {
 		gl.AttachShader(prog, shad)
@@ -0,0 +1,113 @@
+// Package gltest provides helpers for using gll in tests.
+package gltest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vktec/gll"
+)
+
+// FailOnGLError fails the test on any GL error or any debug message of high severity.
+// See FailOnGLErrorSeverity for details.
+func FailOnGLError(t testing.TB, gl gll.GL100) {
+	t.Helper()
+	FailOnGLErrorSeverity(t, gl, gll.DEBUG_SEVERITY_HIGH)
+}
+
+// FailOnGLErrorSeverity installs a debug message callback that reports every message to t.
+// Messages with a severity of at least minSeverity fail the test; all others are logged.
+// When the test finishes, any errors remaining in the GL error queue also fail the test.
+//
+// The callback uses synchronous debug output, so each message is reported with the Go call site that caused it.
+func FailOnGLErrorSeverity(t testing.TB, gl gll.GL100, minSeverity uint32) {
+	t.Helper()
+	minRank := severityRank(minSeverity)
+	gll.DebugMessageCallbackSync(gl, func(msg gll.DebugMessage) {
+		if severityRank(msg.Severity) >= minRank {
+			t.Errorf("%s", FormatDebugMessage(msg))
+		} else {
+			t.Logf("%s", FormatDebugMessage(msg))
+		}
+	})
+
+	t.Cleanup(func() {
+		// The error queue can hold at most one error per error flag, so this loop always terminates on a working context
+		for i := 0; i < maxErrors; i++ {
+			err := gl.GetError()
+			if err == gll.NO_ERROR {
+				break
+			}
+			t.Errorf("GL error: %s", gll.ErrorName(err))
+		}
+		gl.Disable(gll.DEBUG_OUTPUT)
+	})
+}
+
+// FormatDebugMessage formats a debug message with its enums decoded, in the form
+//
+//	file.go:42: HIGH API ERROR 0x502: message
+func FormatDebugMessage(msg gll.DebugMessage) string {
+	s := fmt.Sprintf("%s %s %s %#x: %s",
+		enumName(severityNames, msg.Severity),
+		enumName(sourceNames, msg.Source),
+		enumName(typeNames, msg.Type),
+		msg.ID, msg.Message,
+	)
+	if msg.Caller.File != "" {
+		s = fmt.Sprintf("%s:%d: %s", msg.Caller.File, msg.Caller.Line, s)
+	}
+	return s
+}
+
+func enumName(names map[uint32]string, value uint32) string {
+	if name, ok := names[value]; ok {
+		return name
+	}
+	return fmt.Sprintf("%#x", value)
+}
+
+// severityRank orders debug severities from least to most severe. Unknown severities rank highest.
+func severityRank(severity uint32) int {
+	switch severity {
+	case gll.DEBUG_SEVERITY_NOTIFICATION:
+		return 0
+	case gll.DEBUG_SEVERITY_LOW:
+		return 1
+	case gll.DEBUG_SEVERITY_MEDIUM:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// maxErrors is the number of distinct errors GetError can report
+const maxErrors = 10
+
+var sourceNames = map[uint32]string{
+	gll.DEBUG_SOURCE_API:             "API",
+	gll.DEBUG_SOURCE_WINDOW_SYSTEM:   "WINDOW_SYSTEM",
+	gll.DEBUG_SOURCE_SHADER_COMPILER: "SHADER_COMPILER",
+	gll.DEBUG_SOURCE_THIRD_PARTY:     "THIRD_PARTY",
+	gll.DEBUG_SOURCE_APPLICATION:     "APPLICATION",
+	gll.DEBUG_SOURCE_OTHER:           "OTHER",
+}
+
+var typeNames = map[uint32]string{
+	gll.DEBUG_TYPE_ERROR:               "ERROR",
+	gll.DEBUG_TYPE_DEPRECATED_BEHAVIOR: "DEPRECATED_BEHAVIOR",
+	gll.DEBUG_TYPE_UNDEFINED_BEHAVIOR:  "UNDEFINED_BEHAVIOR",
+	gll.DEBUG_TYPE_PORTABILITY:         "PORTABILITY",
+	gll.DEBUG_TYPE_PERFORMANCE:         "PERFORMANCE",
+	gll.DEBUG_TYPE_MARKER:              "MARKER",
+	gll.DEBUG_TYPE_PUSH_GROUP:          "PUSH_GROUP",
+	gll.DEBUG_TYPE_POP_GROUP:           "POP_GROUP",
+	gll.DEBUG_TYPE_OTHER:               "OTHER",
+}
+
+var severityNames = map[uint32]string{
+	gll.DEBUG_SEVERITY_NOTIFICATION: "NOTIFICATION",
+	gll.DEBUG_SEVERITY_LOW:          "LOW",
+	gll.DEBUG_SEVERITY_MEDIUM:       "MEDIUM",
+	gll.DEBUG_SEVERITY_HIGH:         "HIGH",
+}
@@ -0,0 +1,44 @@
+package gltest
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/vktec/gll"
+)
+
+func TestFormatDebugMessage(t *testing.T) {
+	msg := gll.DebugMessage{
+		Source:   gll.DEBUG_SOURCE_API,
+		Type:     gll.DEBUG_TYPE_ERROR,
+		ID:       0x502,
+		Severity: gll.DEBUG_SEVERITY_HIGH,
+		Message:  "GL_INVALID_OPERATION in glDrawArrays",
+		Caller:   runtime.Frame{File: "render.go", Line: 42},
+	}
+	expected := "render.go:42: HIGH API ERROR 0x502: GL_INVALID_OPERATION in glDrawArrays"
+	if s := FormatDebugMessage(msg); s != expected {
+		t.Errorf("Incorrect message:\n\t%q\n\t%q", expected, s)
+	}
+
+	msg.Caller = runtime.Frame{}
+	msg.Type = 0x1234
+	expected = "HIGH API 0x1234 0x502: GL_INVALID_OPERATION in glDrawArrays"
+	if s := FormatDebugMessage(msg); s != expected {
+		t.Errorf("Incorrect message:\n\t%q\n\t%q", expected, s)
+	}
+}
+
+func TestSeverityRank(t *testing.T) {
+	severities := []uint32{
+		gll.DEBUG_SEVERITY_NOTIFICATION,
+		gll.DEBUG_SEVERITY_LOW,
+		gll.DEBUG_SEVERITY_MEDIUM,
+		gll.DEBUG_SEVERITY_HIGH,
+	}
+	for i := 1; i < len(severities); i++ {
+		if severityRank(severities[i-1]) >= severityRank(severities[i]) {
+			t.Errorf("Severity %#x does not rank below %#x", severities[i-1], severities[i])
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package gll
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// objectName returns the object name in an argument of type uint32 or one of the handle types, such as Buffer
+func objectName(arg Arg) (name uint32, ok bool) {
+	v := reflect.ValueOf(arg)
+	if v.Kind() != reflect.Uint32 {
+		return 0, false
+	}
+	return uint32(v.Uint()), true
+}
+
+// withName returns an argument of the same type as arg, which is a uint32 or one of the handle types, holding name
+func withName(arg Arg, name uint32) Arg {
+	return reflect.ValueOf(name).Convert(reflect.TypeOf(arg)).Interface()
+}
+
+// objectNames returns the array of object names in an argument of type *uint32 or a pointer to one of the handle types
+func objectNames(arg Arg) (names *uint32, ok bool) {
+	v := reflect.ValueOf(arg)
+	if v.Kind() != reflect.Ptr || v.Type().Elem().Kind() != reflect.Uint32 {
+		return nil, false
+	}
+	return (*uint32)(unsafe.Pointer(v.Pointer())), true
+}
@@ -0,0 +1,125 @@
+package gll
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// CommandID identifies a GL command. There is a CommandID for every command in the latest GL version and its extensions.
+type CommandID int
+
+func (cmd CommandID) String() string {
+	return commandInfo[cmd].Name
+}
+
+// Info returns metadata about the command, derived from the registry
+func (cmd CommandID) Info() *CommandInfo {
+	return &commandInfo[cmd]
+}
+
+// Call executes the command on gl with the given arguments, returning its return value or nil.
+// The dynamic types of the arguments must match the Go types of the command's parameters.
+func (cmd CommandID) Call(gl GL460, args []Arg) Arg {
+	return callCommand(gl, cmd, args)
+}
+
+// CommandInfo describes a GL command and its parameters
+type CommandInfo struct {
+	Name        string // The C name of the command, eg. "glBindBuffer"
+	Params      []ParamInfo
+	Return      string // The Go return type, or "" if the command returns nothing
+	ReturnClass string // The class of object named by the return value, if any
+	ReturnGroup string // The enum group of the return value, if any
+}
+
+// ArgLen returns the number of elements in the array passed as argument i, according to the registry's len expression for that parameter.
+// Constants, parameter names and products of these are supported; ok is false for any other expression.
+func (info *CommandInfo) ArgLen(args []Arg, i int) (n int, ok bool) {
+	expr := info.Params[i].Len
+	if expr == "" {
+		return 0, false
+	}
+	n = 1
+	for _, factor := range strings.Split(expr, "*") {
+		v, ok := info.lenFactor(args, factor)
+		if !ok {
+			return 0, false
+		}
+		n *= v
+	}
+	return n, true
+}
+
+func (info *CommandInfo) lenFactor(args []Arg, factor string) (int, bool) {
+	if v, err := strconv.Atoi(factor); err == nil {
+		return v, true
+	}
+	for i, par := range info.Params {
+		// Parameters named after Go keywords have an underscore appended
+		if par.Name != factor && par.Name != factor+"_" {
+			continue
+		}
+		v := reflect.ValueOf(args[i])
+		switch v.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return int(v.Int()), true
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return int(v.Uint()), true
+		}
+	}
+	return 0, false
+}
+
+// ParamInfo describes a parameter of a GL command
+type ParamInfo struct {
+	Name  string
+	Type  string // The Go type of the parameter
+	Group string // The enum group of the parameter, if any
+	Len   string // The registry's expression for the length of the array the parameter points to, if any
+	Class string // The class of object named by the parameter, eg. "buffer", if any
+	CType string // The C type of the parameter as declared by the registry, eg. "const GLchar *"
+}
+
+// CString returns whether the parameter is a NUL-terminated string, such as the name passed to glGetUniformLocation.
+// Strings passed with an explicit length, such as the label of glObjectLabel, are not included.
+func (par *ParamInfo) CString() bool {
+	isChar := par.CType == "const GLchar *" || par.CType == "const GLcharARB *"
+	return isChar && (par.Len == "" || par.Len == "COMPSIZE("+strings.TrimSuffix(par.Name, "_")+")")
+}
+
+// EnumName returns the registry name of value within an enum group, or "" if the group has no such member
+func EnumName(group string, value uint32) string {
+	return enumGroups[group][value]
+}
+
+// Arg is the value of a single argument to, or the return value of, a GL command.
+// Its dynamic type is the Go type given by the corresponding ParamInfo or CommandInfo.
+type Arg interface{}
+
+// Hooks observes the commands executed through a GL returned by Hooked.
+type Hooks interface {
+	// Before is called before every command, with the arguments it was passed
+	Before(cmd CommandID, args []Arg)
+	// After is called after every command, with its return value or nil if it returns nothing
+	After(cmd CommandID, ret Arg)
+}
+
+// Hooked returns a GL that calls hooks before and after every command it forwards to gl.
+// Any value returned from one of the New functions implements GL460, whatever version it was loaded for.
+func Hooked(gl GL460, hooks Hooks) GL460 {
+	return &hooked{gl, hooks}
+}
+
+type hooked struct {
+	next  GL460
+	hooks Hooks
+}
+
+// Dispatch returns a GL that implements every command by calling fn with the command's arguments.
+// fn must return a value of the command's Go return type, or nil if the command returns nothing.
+func Dispatch(fn func(cmd CommandID, args []Arg) Arg) GL460 {
+	return dispatched(fn)
+}
+
+type dispatched func(cmd CommandID, args []Arg) Arg
@@ -0,0 +1,99 @@
+// Package headless creates OpenGL contexts that are not attached to any window, using EGL.
+package headless
+
+/*
+#cgo pkg-config: egl
+#include <EGL/egl.h>
+#include <EGL/eglext.h>
+#include <stdlib.h>
+
+static EGLDisplay gllGetDisplay(void) {
+	PFNEGLGETPLATFORMDISPLAYEXTPROC getPlatformDisplay = (PFNEGLGETPLATFORMDISPLAYEXTPROC)eglGetProcAddress("eglGetPlatformDisplayEXT");
+	if (getPlatformDisplay) {
+		EGLDisplay dpy = getPlatformDisplay(EGL_PLATFORM_SURFACELESS_MESA, EGL_DEFAULT_DISPLAY, NULL);
+		if (dpy != EGL_NO_DISPLAY) {
+			return dpy;
+		}
+	}
+	return eglGetDisplay(EGL_DEFAULT_DISPLAY);
+}
+*/
+import "C"
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// The EGL_NO_* macros can't be used from Go, but are all zero
+var (
+	noDisplay C.EGLDisplay
+	noConfig  C.EGLConfig
+	noSurface C.EGLSurface
+	noContext C.EGLContext
+)
+
+// Context is a headless OpenGL context
+type Context struct {
+	dpy C.EGLDisplay
+	ctx C.EGLContext
+}
+
+// New creates a headless core profile context of the given version and makes it current on the calling thread.
+// The calling goroutine is locked to its thread until the context is destroyed.
+func New(major, minor int) (*Context, error) {
+	runtime.LockOSThread()
+	c := &Context{}
+	c.dpy = C.gllGetDisplay()
+	if c.dpy == noDisplay {
+		return nil, c.fail("eglGetDisplay")
+	}
+	if C.eglInitialize(c.dpy, nil, nil) == C.EGL_FALSE {
+		return nil, c.fail("eglInitialize")
+	}
+	if C.eglBindAPI(C.EGL_OPENGL_API) == C.EGL_FALSE {
+		return nil, c.fail("eglBindAPI")
+	}
+
+	attribs := []C.EGLint{
+		C.EGL_CONTEXT_MAJOR_VERSION, C.EGLint(major),
+		C.EGL_CONTEXT_MINOR_VERSION, C.EGLint(minor),
+		C.EGL_CONTEXT_OPENGL_PROFILE_MASK, C.EGL_CONTEXT_OPENGL_CORE_PROFILE_BIT,
+		C.EGL_NONE,
+	}
+	c.ctx = C.eglCreateContext(c.dpy, noConfig, noContext, &attribs[0])
+	if c.ctx == noContext {
+		return nil, c.fail("eglCreateContext")
+	}
+	if C.eglMakeCurrent(c.dpy, noSurface, noSurface, c.ctx) == C.EGL_FALSE {
+		return nil, c.fail("eglMakeCurrent")
+	}
+	return c, nil
+}
+
+func (c *Context) fail(fn string) error {
+	err := fmt.Errorf("%s failed: EGL error %#x", fn, C.eglGetError())
+	c.Destroy()
+	return err
+}
+
+// GetProcAddress loads a GL function, for use with the gll New functions
+func (c *Context) GetProcAddress(name string) unsafe.Pointer {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return unsafe.Pointer(C.eglGetProcAddress(cname))
+}
+
+// Destroy releases the context and unlocks the calling goroutine from its thread
+func (c *Context) Destroy() {
+	if c.ctx != noContext {
+		C.eglMakeCurrent(c.dpy, noSurface, noSurface, noContext)
+		C.eglDestroyContext(c.dpy, c.ctx)
+		c.ctx = noContext
+	}
+	if c.dpy != noDisplay {
+		C.eglTerminate(c.dpy)
+		c.dpy = noDisplay
+	}
+	runtime.UnlockOSThread()
+}
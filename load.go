@@ -0,0 +1,41 @@
+package gll
+
+import "unsafe"
+
+// LoadReport describes how the commands of a GL were loaded
+type LoadReport struct {
+	// Aliases maps each core command the driver did not provide to the ARB, EXT or KHR alias that was loaded in its place.
+	// Alias fallback can be disabled with the gll_noaliases build tag.
+	Aliases map[string]string
+}
+
+// LoadReporter is implemented by every GL returned from one of the New functions
+type LoadReporter interface {
+	LoadReport() LoadReport
+}
+
+type loadState struct {
+	aliases map[string]string
+}
+
+func (gl *lib) LoadReport() LoadReport {
+	aliases := make(map[string]string, len(gl.aliases))
+	for cmd, alias := range gl.aliases {
+		aliases[cmd] = alias
+	}
+	return LoadReport{aliases}
+}
+
+// loadAlias returns the first of aliases that getProcAddr provides, recording it as used in place of cmd
+func (gl *lib) loadAlias(getProcAddr func(name string) unsafe.Pointer, cmd string, aliases ...string) unsafe.Pointer {
+	for _, alias := range aliases {
+		if p := getProcAddr(alias); p != nil {
+			if gl.aliases == nil {
+				gl.aliases = make(map[string]string)
+			}
+			gl.aliases[cmd] = alias
+			return p
+		}
+	}
+	return nil
+}
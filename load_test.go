@@ -0,0 +1,32 @@
+package gll
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestAliasFallback(t *testing.T) {
+	if !aliasFallback {
+		t.Skip("alias fallback disabled")
+	}
+	var fn byte
+	provided := map[string]bool{
+		"glActiveTextureARB":   true,
+		"glGenFramebuffers":    true,
+		"glGenFramebuffersEXT": true,
+	}
+	gl := New330(func(name string) unsafe.Pointer {
+		if provided[name] {
+			return unsafe.Pointer(&fn)
+		}
+		return nil
+	}).(*lib)
+
+	if gl.glActiveTexture == nil {
+		t.Error("glActiveTexture not loaded from glActiveTextureARB")
+	}
+	report := gl.LoadReport()
+	if len(report.Aliases) != 1 || report.Aliases["glActiveTexture"] != "glActiveTextureARB" {
+		t.Errorf("Incorrect aliases in load report: %v", report.Aliases)
+	}
+}
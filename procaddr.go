@@ -0,0 +1,44 @@
+package gll
+
+// void *gllExportedProcAddress(char *name);
+import "C"
+import (
+	"sync/atomic"
+	"unsafe"
+)
+
+// ProcAddresser is implemented by every GL returned from one of the New functions.
+// ProcAddress returns the function pointer gll calls for the named command, eg. "glDrawArrays",
+// or nil if the command was not loaded. Names gll does not know are passed to the getProcAddr function the GL was loaded with.
+//
+// Calling the returned pointers bypasses any hooks or wrappers around the GL.
+type ProcAddresser interface {
+	ProcAddress(name string) unsafe.Pointer
+}
+
+var exportedGL atomic.Value // ProcAddresser
+
+// ExportProcAddress makes gl's function pointers available to C code in the same process.
+// It returns a C function pointer of type
+//
+//	void *(*)(const char *name)
+//
+// that looks up names with gl.ProcAddress, and can be passed to C libraries that load GL with a getProcAddr function.
+// There is only one exported GL per process; each call replaces the GL used by every pointer previously returned.
+func ExportProcAddress(gl ProcAddresser) unsafe.Pointer {
+	exportedGL.Store(&gl)
+	return unsafe.Pointer(C.gllExportedProcAddress)
+}
+
+func exportedProcAddress(name string) unsafe.Pointer {
+	gl, ok := exportedGL.Load().(*ProcAddresser)
+	if !ok {
+		return nil
+	}
+	return (*gl).ProcAddress(name)
+}
+
+//export gllExportedProcAddress
+func gllExportedProcAddress(name *C.char) unsafe.Pointer {
+	return exportedProcAddress(C.GoString(name))
+}
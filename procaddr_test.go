@@ -0,0 +1,33 @@
+package gll
+
+import (
+	"testing"
+
+	"github.com/vktec/gll/internal/headless"
+)
+
+func TestProcAddress(t *testing.T) {
+	ctx, err := headless.New(4, 5)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ctx.Destroy()
+
+	gl := New450(ctx.GetProcAddress).(ProcAddresser)
+	for _, name := range []string{"glGetString", "glDrawArrays", "glCreateBuffers"} {
+		if p := gl.ProcAddress(name); p == nil || p != ctx.GetProcAddress(name) {
+			t.Errorf("Incorrect address for %s: %p", name, p)
+		}
+	}
+	// Commands added after GL 4.5 are not loaded
+	if p := gl.ProcAddress("glSpecializeShader"); p != nil {
+		t.Errorf("Expected nil address for glSpecializeShader, got %p", p)
+	}
+
+	if ExportProcAddress(gl) == nil {
+		t.Fatal("Exported function pointer is nil")
+	}
+	if p := exportedProcAddress("glGetString"); p != gl.ProcAddress("glGetString") {
+		t.Errorf("Incorrect exported address for glGetString: %p", p)
+	}
+}
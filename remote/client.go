@@ -0,0 +1,230 @@
+package remote
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"unsafe"
+
+	"github.com/vktec/gll"
+)
+
+// Client is a GL that executes every command on a Server.
+//
+// The memory referenced by a pointer argument is sent to the Server if its size can be determined from the registry.
+// Pointers of unknown size that may be offsets into a buffer object are sent as addresses.
+// Commands with any other pointer of unknown size are not sent; the Client fails with an error instead.
+// Mapping buffers is not supported: MapBuffer and similar commands return nil.
+//
+// If the connection fails, every later command does nothing and returns the zero value. The error is returned by Err.
+type Client struct {
+	gll.GL460
+
+	conn  conn
+	cmds  map[gll.CommandID]uint64
+	mem   *gll.ArgMemory
+	syncs map[gll.GLsync]uint64
+	addrs map[uint64]gll.GLsync
+	err   error
+}
+
+var _ gll.GL460 = (*Client)(nil)
+
+// NewClient returns a Client that sends commands over rw
+func NewClient(rw io.ReadWriter) *Client {
+	c := &Client{
+		conn:  newConn(rw),
+		cmds:  make(map[gll.CommandID]uint64),
+		mem:   gll.NewArgMemory(),
+		syncs: make(map[gll.GLsync]uint64),
+		addrs: make(map[uint64]gll.GLsync),
+	}
+	c.GL460 = gll.Dispatch(c.call)
+	return c
+}
+
+// FlushConn sends any buffered commands to the Server, and returns the first error encountered by the Client.
+// Commands that do not return anything are buffered until a command that does, or until FlushConn or Flush is called.
+func (c *Client) FlushConn() error {
+	if c.err == nil {
+		c.err = c.conn.w.Flush()
+	}
+	return c.err
+}
+
+// Flush sends glFlush, along with any buffered commands, to the Server
+func (c *Client) Flush() {
+	c.GL460.Flush()
+	c.FlushConn()
+}
+
+// Err returns the first error encountered by the Client
+func (c *Client) Err() error {
+	return c.err
+}
+
+// output is client memory that is sent to the Server and may be written by the command
+type output struct {
+	addr unsafe.Pointer
+	size int
+}
+
+func (c *Client) call(cmd gll.CommandID, args []gll.Arg) gll.Arg {
+	info := cmd.Info()
+	var ret gll.Arg
+	if info.Return != "" {
+		ret = reflect.Zero(gll.ArgType(info.Return)).Interface()
+	}
+	if c.err != nil {
+		return ret
+	}
+
+	if err := c.checkSizes(cmd, args); err != nil {
+		c.err = err
+		return ret
+	}
+
+	idx, ok := c.cmds[cmd]
+	if !ok {
+		idx = uint64(len(c.cmds))
+		c.cmds[cmd] = idx
+	}
+	c.conn.writeUvarint(idx)
+	if !ok {
+		c.conn.writeString(info.Name)
+	}
+	var outputs []output
+	for i, arg := range args {
+		if out, ok := c.writeArg(cmd, args, i, arg); ok {
+			outputs = append(outputs, out)
+		}
+	}
+	c.mem.Update(cmd, args)
+	if !needsResponse(info, len(outputs)) {
+		return nil
+	}
+
+	if c.FlushConn() != nil {
+		return ret
+	}
+	if info.Return != "" {
+		if ret, c.err = c.readReturn(gll.ArgType(info.Return)); c.err != nil {
+			return ret
+		}
+	}
+	if outputCommand(info.Name) {
+		for _, out := range outputs {
+			var data []byte
+			if data, c.err = c.conn.readBytes(); c.err != nil {
+				return ret
+			}
+			if len(data) != out.size {
+				c.err = errProtocol
+				return ret
+			}
+			copy(bytesAt(out.addr, out.size), data)
+		}
+	}
+	return ret
+}
+
+func (c *Client) writeArg(cmd gll.CommandID, args []gll.Arg, i int, arg gll.Arg) (out output, ok bool) {
+	if sync, isSync := arg.(gll.GLsync); isSync {
+		if sync == nil {
+			c.conn.w.WriteByte(ptrNil)
+		} else {
+			c.conn.w.WriteByte(ptrAddr)
+			c.conn.writeUint(c.syncs[sync], 8)
+		}
+		return out, false
+	}
+
+	v := reflect.ValueOf(arg)
+	if c.conn.writeScalar(v) {
+		return out, false
+	}
+	if v.Kind() != reflect.Ptr && v.Kind() != reflect.UnsafePointer {
+		panic("remote: cannot send argument of type " + v.Type().String())
+	}
+
+	addr := unsafe.Pointer(v.Pointer())
+	if addr == nil {
+		c.conn.w.WriteByte(ptrNil)
+		return out, false
+	}
+	if v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Ptr {
+		if strs, ok := gll.ArgStrings(cmd, args, i); ok {
+			c.conn.w.WriteByte(ptrStrs)
+			c.conn.writeUvarint(uint64(len(strs)))
+			for _, s := range strs {
+				c.conn.writeString(s)
+			}
+			return out, false
+		}
+	}
+
+	size, ok := c.mem.Size(cmd, args, i)
+	if !ok || size < 0 {
+		c.conn.w.WriteByte(ptrAddr)
+		c.conn.writeUint(uint64(v.Pointer()), 8)
+		return out, false
+	}
+	c.conn.w.WriteByte(ptrMem)
+	c.conn.writeBytes(bytesAt(addr, size))
+	return output{addr, size}, true
+}
+
+// checkSizes returns an error if a command has a pointer to client memory that can't be sent
+func (c *Client) checkSizes(cmd gll.CommandID, args []gll.Arg) error {
+	for i, arg := range args {
+		v := reflect.ValueOf(arg)
+		if v.Kind() != reflect.Ptr && v.Kind() != reflect.UnsafePointer || v.Pointer() == 0 {
+			continue
+		}
+		if _, isSync := arg.(gll.GLsync); isSync {
+			continue
+		}
+		if v.Kind() == reflect.Ptr && v.Type().Elem().Kind() == reflect.Ptr {
+			if _, ok := gll.ArgStrings(cmd, args, i); ok {
+				continue
+			}
+		}
+		if size, ok := c.mem.Size(cmd, args, i); (!ok || size < 0) && gll.PointerBinding(cmd, i) == 0 {
+			return fmt.Errorf("remote: cannot send %s: the size of %s is unknown", cmd, cmd.Info().Params[i].Name)
+		}
+	}
+	return nil
+}
+
+func (c *Client) readReturn(t reflect.Type) (gll.Arg, error) {
+	v, ok, err := c.conn.readScalar(t)
+	if ok {
+		return v.Interface(), err
+	}
+
+	tag, addr, err := c.conn.readTag()
+	if err != nil {
+		return reflect.Zero(t).Interface(), err
+	}
+	switch {
+	case tag == ptrNil:
+		return reflect.Zero(t).Interface(), nil
+	case t == syncType && tag == ptrAddr:
+		sync, ok := c.addrs[addr]
+		if !ok {
+			// The Client's sync values are unique tokens standing for the Server's
+			sync = gll.GLsync(unsafe.Pointer(new(byte)))
+			c.addrs[addr] = sync
+			c.syncs[sync] = addr
+		}
+		return sync, nil
+	case t == reflect.TypeOf((*uint8)(nil)) && tag == ptrMem:
+		str, err := c.conn.readBytes()
+		if err != nil {
+			return reflect.Zero(t).Interface(), err
+		}
+		return &append(str, 0)[0], nil
+	default:
+		return reflect.Zero(t).Interface(), fmt.Errorf("remote: unexpected pointer tag %d for %s", tag, t)
+	}
+}
@@ -0,0 +1,188 @@
+// Package remote executes GL commands in another process.
+//
+// A Client implements every GL interface by sending each command over a connection to a Server,
+// which executes it on its own context. Client memory referenced by pointer arguments is sent along with the command,
+// and the Server sends back return values and the contents of output arrays.
+//
+// The protocol is similar to the trace format written by gll.Capture. All integers are little endian.
+// Each call is sent as a uvarint command index, followed by the command name if the index has not been used before,
+// followed by the arguments. Scalars are encoded with the size of their Go type; int, uint and uintptr are 8 bytes.
+// Function arguments, ie. debug callbacks, are omitted and replaced by a callback that does nothing.
+// Pointers and GLsync values start with a tag byte:
+//   - ptrNil: a null pointer.
+//   - ptrAddr: a uint64 address, eg. an offset into a buffer object, or a GLsync value returned by the Server.
+//   - ptrMem: a string containing the memory the pointer refers to.
+//   - ptrStrs: a uvarint count followed by that many strings, for arrays of strings such as shader sources.
+//
+// Strings are a uvarint length followed by that many bytes, and may be at most 1 GiB long.
+// The Server rejects calls whose memory is not the size the command reads or writes, as computed by gll.ArgMemory.
+// The Server responds to calls that return a value or write to output arrays, but not to other calls.
+// The response contains the return value, then the new contents of each ptrMem argument.
+// Returned strings are sent as a ptrMem tagged pointer; other returned pointers are always sent as ptrNil.
+package remote
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"unsafe"
+
+	"github.com/vktec/gll"
+)
+
+const (
+	ptrNil = iota
+	ptrAddr
+	ptrMem
+	ptrStrs
+)
+
+var errProtocol = errors.New("remote: protocol error")
+
+// maxBytes is the largest string accepted in a message, so that a message can't make the receiver allocate without bound
+const maxBytes = 1 << 30
+
+var syncType = reflect.TypeOf(gll.GLsync(nil))
+
+// outputCommand reports whether the memory passed to a command may be written by it
+func outputCommand(name string) bool {
+	for _, prefix := range []string{"glGet", "glGen", "glCreate", "glRead", "glAre", "glQuery"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// needsResponse reports whether the Server sends a response to a call
+func needsResponse(info *gll.CommandInfo, memArgs int) bool {
+	return info.Return != "" || memArgs > 0 && outputCommand(info.Name)
+}
+
+type conn struct {
+	r *bufio.Reader
+	w *bufio.Writer
+}
+
+func newConn(rw io.ReadWriter) conn {
+	return conn{bufio.NewReader(rw), bufio.NewWriter(rw)}
+}
+
+func (c conn) writeUint(v uint64, size int) {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], v)
+	c.w.Write(b[:size])
+}
+func (c conn) writeUvarint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	c.w.Write(b[:binary.PutUvarint(b[:], v)])
+}
+func (c conn) writeString(s string) {
+	c.writeUvarint(uint64(len(s)))
+	c.w.WriteString(s)
+}
+func (c conn) writeBytes(b []byte) {
+	c.writeUvarint(uint64(len(b)))
+	c.w.Write(b)
+}
+
+func (c conn) readUint(size int) (uint64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(c.r, b[:size]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b[:]), nil
+}
+func (c conn) readBytes() ([]byte, error) {
+	n, err := binary.ReadUvarint(c.r)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxBytes {
+		return nil, errProtocol
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(c.r, b)
+	return b, err
+}
+func (c conn) readString() (string, error) {
+	b, err := c.readBytes()
+	return string(b), err
+}
+
+// writeScalar writes a value that is not a pointer, reporting whether it was one
+func (c conn) writeScalar(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Func:
+	case reflect.Bool:
+		if v.Bool() {
+			c.w.WriteByte(1)
+		} else {
+			c.w.WriteByte(0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		c.writeUint(uint64(v.Int()), int(v.Type().Size()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		c.writeUint(v.Uint(), int(v.Type().Size()))
+	case reflect.Float32:
+		c.writeUint(uint64(math.Float32bits(float32(v.Float()))), 4)
+	case reflect.Float64:
+		c.writeUint(math.Float64bits(v.Float()), 8)
+	default:
+		return false
+	}
+	return true
+}
+
+// readScalar reads a value of a type that is not a pointer, reporting whether it was one
+func (c conn) readScalar(t reflect.Type) (v reflect.Value, ok bool, err error) {
+	v = reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Func:
+		v = reflect.MakeFunc(t, func([]reflect.Value) []reflect.Value { return nil })
+	case reflect.Bool:
+		var b byte
+		b, err = c.r.ReadByte()
+		v.SetBool(b != 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n uint64
+		n, err = c.readUint(int(t.Size()))
+		// Sign extend
+		shift := 64 - 8*t.Size()
+		v.SetInt(int64(n<<shift) >> shift)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var n uint64
+		n, err = c.readUint(int(t.Size()))
+		v.SetUint(n)
+	case reflect.Float32:
+		var n uint64
+		n, err = c.readUint(4)
+		v.SetFloat(float64(math.Float32frombits(uint32(n))))
+	case reflect.Float64:
+		var n uint64
+		n, err = c.readUint(8)
+		v.SetFloat(math.Float64frombits(n))
+	default:
+		return v, false, nil
+	}
+	return v, true, err
+}
+
+// readTag reads a pointer tag, and the address that follows it if there is one
+func (c conn) readTag() (tag byte, addr uint64, err error) {
+	tag, err = c.r.ReadByte()
+	if err != nil || tag != ptrAddr {
+		return tag, 0, err
+	}
+	addr, err = c.readUint(8)
+	return tag, addr, err
+}
+
+// bytesAt returns the n bytes of memory at addr
+func bytesAt(addr unsafe.Pointer, n int) []byte {
+	return (*[1 << 40]byte)(addr)[:n:n]
+}
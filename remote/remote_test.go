@@ -0,0 +1,182 @@
+package remote
+
+import (
+	"bytes"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+
+	"github.com/vktec/gll"
+	"github.com/vktec/gll/glfake"
+	"github.com/vktec/gll/internal/headless"
+)
+
+func socketPair(t *testing.T) (net.Conn, net.Conn) {
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conns := make([]net.Conn, 2)
+	for i, fd := range fds {
+		f := os.NewFile(uintptr(fd), "socketpair")
+		conns[i], err = net.FileConn(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return conns[0], conns[1]
+}
+
+func TestRemote(t *testing.T) {
+	cconn, sconn := socketPair(t)
+	started := make(chan error, 1)
+	done := make(chan error, 1)
+	go func() {
+		ctx, err := headless.New(4, 5)
+		started <- err
+		if err != nil {
+			return
+		}
+		err = Serve(gll.New460(ctx.GetProcAddress), sconn)
+		// Destroy the context before the test ends, so the next test can create one
+		ctx.Destroy()
+		done <- err
+	}()
+	if err := <-started; err != nil {
+		t.Skip(err)
+	}
+
+	gl := NewClient(cconn)
+	var buffers [2]gll.Buffer
+	gl.GenBuffers(2, &buffers[0])
+	if buffers[0] == 0 || buffers[1] == 0 || buffers[0] == buffers[1] {
+		t.Errorf("Invalid buffer names %v", buffers)
+	}
+	gl.BindBuffer(gll.ARRAY_BUFFER, buffers[1])
+	data := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	gl.BufferData(gll.ARRAY_BUFFER, len(data), gll.Ptr(data), gll.STATIC_DRAW)
+	read := make([]byte, 4)
+	gl.GetBufferSubData(gll.ARRAY_BUFFER, 2, len(read), gll.Ptr(read))
+	if string(read) != string(data[2:6]) {
+		t.Errorf("Expected buffer contents %v, got %v", data[2:6], read)
+	}
+
+	if version := gll.GoStr(gl.GetString(gll.VERSION)); version == "" {
+		t.Error("Empty version string")
+	}
+	var size int32
+	gl.GetIntegerv(gll.MAX_TEXTURE_SIZE, &size)
+	if size <= 0 {
+		t.Errorf("Invalid max texture size %d", size)
+	}
+	viewport := [4]int32{-1, -1, -1, -1}
+	gl.Viewport(1, 2, 3, 4)
+	gl.GetIntegerv(gll.VIEWPORT, &viewport[0])
+	if viewport != [4]int32{1, 2, 3, 4} {
+		t.Errorf("Expected viewport [1 2 3 4], got %v", viewport)
+	}
+
+	// Offsets into the bound buffer are sent as addresses
+	var vao gll.VertexArray
+	gl.GenVertexArrays(1, &vao)
+	gl.BindVertexArray(vao)
+	gl.VertexAttribPointer(0, 2, gll.FLOAT, false, 0, gll.Offset(4))
+	if prog := gl.CreateProgram(); prog == 0 {
+		t.Error("CreateProgram returned 0")
+	}
+	if err := gl.GetError(); err != gll.NO_ERROR {
+		t.Errorf("GL error %#x", err)
+	}
+
+	if err := gl.FlushConn(); err != nil {
+		t.Fatal(err)
+	}
+	cconn.Close()
+	if err := <-done; err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRemoteUnknownSize(t *testing.T) {
+	cconn, sconn := socketPair(t)
+	defer cconn.Close()
+	defer sconn.Close()
+	gl := NewClient(cconn)
+
+	// The number of values depends on GL_NUM_COMPRESSED_TEXTURE_FORMATS
+	var formats [64]int32
+	gl.GetIntegerv(gll.COMPRESSED_TEXTURE_FORMATS, &formats[0])
+	expected := "remote: cannot send glGetIntegerv: the size of data is unknown"
+	if err := gl.Err(); err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+}
+
+func TestServeUnboundOffset(t *testing.T) {
+	cconn, sconn := socketPair(t)
+	started := make(chan error, 1)
+	done := make(chan error, 1)
+	go func() {
+		ctx, err := headless.New(4, 5)
+		started <- err
+		if err != nil {
+			return
+		}
+		err = Serve(gll.New460(ctx.GetProcAddress), sconn)
+		// Destroy the context before the test ends, so the next test can create one
+		ctx.Destroy()
+		done <- err
+	}()
+	if err := <-started; err != nil {
+		t.Skip(err)
+	}
+
+	// No buffer is bound to GL_ARRAY_BUFFER, so the pointer refers to client memory the Client did not send
+	gl := NewClient(cconn)
+	gl.VertexAttribPointer(0, 2, gll.FLOAT, false, 0, gll.Offset(4))
+	gl.FlushConn()
+	expected := "remote: glVertexAttribPointer: pointer is not an offset into a bound buffer"
+	if err := <-done; err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+	cconn.Close()
+}
+
+func TestServeMemorySize(t *testing.T) {
+	// A BufferData call whose data is smaller than its size
+	msg := &bytes.Buffer{}
+	c := newConn(msg)
+	c.writeUvarint(0)
+	c.writeString("glBufferData")
+	c.writeUint(gll.ARRAY_BUFFER, 4)
+	c.writeUint(1<<30, 8)
+	c.w.WriteByte(ptrMem)
+	c.writeBytes([]byte{1, 2, 3, 4})
+	c.writeUint(gll.STATIC_DRAW, 4)
+	c.w.Flush()
+
+	gl := glfake.New()
+	expected := "remote: glBufferData: expected 1073741824 bytes of data, got 4"
+	if err := Serve(gl, msg); err == nil || err.Error() != expected {
+		t.Errorf("Expected error %q, got %v", expected, err)
+	}
+
+	// A string longer than any message may contain
+	msg.Reset()
+	c.writeUvarint(0)
+	c.writeString("glBufferData")
+	c.writeUint(gll.ARRAY_BUFFER, 4)
+	c.writeUint(4, 8)
+	c.w.WriteByte(ptrMem)
+	c.writeUvarint(1 << 40)
+	c.w.Flush()
+	if err := Serve(gl, msg); err != errProtocol {
+		t.Errorf("Expected error %q, got %v", errProtocol, err)
+	}
+
+	if calls := gl.Calls(); len(calls) != 0 {
+		t.Errorf("Expected no calls, got %v", gl.Log())
+	}
+}
@@ -0,0 +1,308 @@
+package remote
+
+// #include <stdlib.h>
+// #include <string.h>
+import "C"
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unsafe"
+
+	"github.com/vktec/gll"
+)
+
+// Serve executes the commands sent by a Client on gl, until the connection is closed.
+// It returns nil if the Client closed the connection between commands.
+func Serve(gl gll.GL460, rw io.ReadWriter) error {
+	s := &server{
+		conn:   newConn(rw),
+		gl:     gl,
+		mem:    gll.NewArgMemory(),
+		syncs:  make(map[uint64]gll.GLsync),
+		arrays: make(map[clientArray]func()),
+	}
+	defer func() {
+		for _, free := range s.arrays {
+			free()
+		}
+	}()
+	for {
+		if _, err := s.conn.r.Peek(1); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := s.call(); err != nil {
+			return err
+		}
+	}
+}
+
+type server struct {
+	conn   conn
+	gl     gll.GL460
+	cmds   []gll.CommandID
+	mem    *gll.ArgMemory
+	syncs  map[uint64]gll.GLsync
+	frees  []func()
+	arrays map[clientArray]func() // Frees the memory used by each client array
+	// clientTexture is the texture unit selected by ClientActiveTexture
+	clientTexture uint32
+}
+
+// clientArray identifies the client array set by a *Pointer command.
+// Arrays set by vertex attribute commands are identified by attribute index, and texture coordinate arrays by texture unit.
+type clientArray struct {
+	cmd   string
+	index uint32
+}
+
+// input is memory received from the Client for argument arg
+type input struct {
+	arg  int
+	addr unsafe.Pointer
+	size int
+	strs []string // The strings received for an array of strings, in which case addr is nil
+}
+
+func (s *server) call() error {
+	idx, err := binary.ReadUvarint(s.conn.r)
+	if err != nil {
+		return err
+	}
+	if idx == uint64(len(s.cmds)) {
+		name, err := s.conn.readString()
+		if err != nil {
+			return err
+		}
+		cmd, ok := gll.CommandByName(name)
+		if !ok {
+			return fmt.Errorf("remote: unknown command %s", name)
+		}
+		s.cmds = append(s.cmds, cmd)
+	} else if idx > uint64(len(s.cmds)) {
+		return errProtocol
+	}
+	cmd := s.cmds[idx]
+	info := cmd.Info()
+
+	args := make([]gll.Arg, len(info.Params))
+	var inputs, strs []input
+	for i := range info.Params {
+		arg, in, err := s.readArg(cmd, i)
+		if err != nil {
+			return err
+		}
+		args[i] = arg
+		if in.addr != nil {
+			inputs = append(inputs, in)
+		} else if in.strs != nil {
+			strs = append(strs, in)
+		}
+	}
+	if err := s.checkSizes(cmd, args, inputs, strs); err != nil {
+		return err
+	}
+
+	ret := cmd.Call(s.gl, args)
+	if info.Return != "" {
+		s.writeReturn(ret)
+	}
+	if outputCommand(info.Name) {
+		for _, in := range inputs {
+			s.conn.writeBytes(bytesAt(in.addr, in.size))
+		}
+	}
+
+	s.mem.Update(cmd, args)
+	if cmd == gll.CmdClientActiveTexture {
+		s.clientTexture = args[0].(uint32)
+	}
+	// Commands that set up client arrays keep using the memory until the array is replaced
+	if array, ok := s.clientArray(info, args); ok {
+		if free := s.arrays[array]; free != nil {
+			free()
+		}
+		frees := append([]func(){}, s.frees...)
+		s.arrays[array] = func() {
+			for _, free := range frees {
+				free()
+			}
+		}
+	} else {
+		for _, free := range s.frees {
+			free()
+		}
+	}
+	s.frees = s.frees[:0]
+
+	if needsResponse(info, len(inputs)) {
+		return s.conn.w.Flush()
+	}
+	return nil
+}
+
+// clientArray returns the client array set by a command, if it is one of the *Pointer commands that set one
+func (s *server) clientArray(info *gll.CommandInfo, args []gll.Arg) (clientArray, bool) {
+	if !strings.Contains(info.Name, "Pointer") || strings.HasPrefix(info.Name, "glGet") {
+		return clientArray{}, false
+	}
+	if strings.HasPrefix(info.Name, "glVertexAttrib") {
+		return clientArray{"glVertexAttribPointer", args[0].(uint32)}, true
+	}
+	if strings.HasPrefix(info.Name, "glTexCoordPointer") {
+		return clientArray{info.Name, s.clientTexture}, true
+	}
+	return clientArray{info.Name, 0}, true
+}
+
+// readArg reads argument i of cmd
+func (s *server) readArg(cmd gll.CommandID, i int) (arg gll.Arg, in input, err error) {
+	info := cmd.Info()
+	t := gll.ArgType(info.Params[i].Type)
+	v, ok, err := s.conn.readScalar(t)
+	if ok {
+		return v.Interface(), in, err
+	}
+
+	tag, addr, err := s.conn.readTag()
+	if err != nil {
+		return nil, in, err
+	}
+	in.arg = i
+	var p unsafe.Pointer
+	switch tag {
+	case ptrNil:
+	case ptrAddr:
+		if t == syncType {
+			return s.syncs[addr], in, nil
+		}
+		// Client memory is always sent, so an address must be an offset into a bound buffer
+		if !s.bufferBound(gll.PointerBinding(cmd, i)) {
+			return nil, in, fmt.Errorf("remote: %s: %s is not an offset into a bound buffer", info.Name, info.Params[i].Name)
+		}
+		p = gll.Offset(int(addr))
+
+	case ptrMem:
+		data, err := s.conn.readBytes()
+		if err != nil {
+			return nil, in, err
+		}
+		// Use C memory, as the GL may keep the pointer.
+		// It is NUL-terminated so that strings that are not can be sized without reading past the end.
+		p = C.calloc(C.size_t(len(data)+1), 1)
+		if len(data) > 0 {
+			C.memcpy(p, unsafe.Pointer(&data[0]), C.size_t(len(data)))
+		}
+		mem := p
+		s.frees = append(s.frees, func() { C.free(mem) })
+		in.addr, in.size = p, len(data)
+
+	case ptrStrs:
+		n, err := binary.ReadUvarint(s.conn.r)
+		if err != nil {
+			return nil, in, err
+		}
+		// The strings are appended as they are read, so a large count can't allocate more than the message contains
+		in.strs = []string{}
+		for j := uint64(0); j < n; j++ {
+			str, err := s.conn.readString()
+			if err != nil {
+				return nil, in, err
+			}
+			in.strs = append(in.strs, str)
+		}
+		if n > 0 {
+			strp, _, free := gll.Strs(in.strs...)
+			s.frees = append(s.frees, free)
+			p = unsafe.Pointer(strp)
+		}
+
+	default:
+		return nil, in, errProtocol
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		return reflect.NewAt(t.Elem(), p).Interface(), in, nil
+	case reflect.UnsafePointer:
+		return p, in, nil
+	default:
+		return nil, in, fmt.Errorf("remote: cannot receive argument of type %s", t)
+	}
+}
+
+// checkSizes returns an error unless the memory received for each argument is the size the command reads or writes.
+// Sizes are computed as by the Client, so the GL never reads or writes past the end of the memory received.
+func (s *server) checkSizes(cmd gll.CommandID, args []gll.Arg, inputs, strs []input) error {
+	info := cmd.Info()
+	for _, in := range inputs {
+		name := info.Params[in.arg].Name
+		size, ok := s.mem.Size(cmd, args, in.arg)
+		if !ok {
+			return fmt.Errorf("remote: %s: the size of %s is unknown", info.Name, name)
+		}
+		if size != in.size {
+			return fmt.Errorf("remote: %s: expected %d bytes of %s, got %d", info.Name, size, name, in.size)
+		}
+	}
+
+	for _, in := range strs {
+		name := info.Params[in.arg].Name
+		n, ok := info.ArgLen(args, in.arg)
+		if !ok || n != len(in.strs) {
+			return fmt.Errorf("remote: %s: expected %d strings in %s, got %d", info.Name, n, name, len(in.strs))
+		}
+		// Lengths are sent with the strings, and must match them
+		for i, par := range info.Params {
+			lengths, _ := args[i].(*int32)
+			if par.Name != "length" || lengths == nil {
+				continue
+			}
+			for j, l := range (*[1 << 28]int32)(unsafe.Pointer(lengths))[:n:n] {
+				if l >= 0 && int(l) != len(in.strs[j]) {
+					return fmt.Errorf("remote: %s: length %d of string %d in %s does not match its contents", info.Name, l, j, name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// bufferBound reports whether a buffer is bound to binding, which is 0 for pointers that never refer to a buffer
+func (s *server) bufferBound(binding uint32) bool {
+	if binding == 0 {
+		return false
+	}
+	var buffer int32
+	s.gl.GetIntegerv(binding, &buffer)
+	return buffer != 0
+}
+
+func (s *server) writeReturn(ret gll.Arg) {
+	if sync, ok := ret.(gll.GLsync); ok {
+		if sync == nil {
+			s.conn.w.WriteByte(ptrNil)
+			return
+		}
+		addr := uint64(uintptr(unsafe.Pointer(sync)))
+		s.syncs[addr] = sync
+		s.conn.w.WriteByte(ptrAddr)
+		s.conn.writeUint(addr, 8)
+		return
+	}
+
+	v := reflect.ValueOf(ret)
+	if s.conn.writeScalar(v) {
+		return
+	}
+	if str, ok := ret.(*uint8); ok && str != nil {
+		s.conn.w.WriteByte(ptrMem)
+		s.conn.writeString(gll.GoStr(str))
+		return
+	}
+	s.conn.w.WriteByte(ptrNil)
+}
@@ -0,0 +1,594 @@
+package gll
+
+// #include <stdlib.h>
+// #include <string.h>
+import "C"
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// Replayer executes traces written by a Capturer
+type Replayer struct {
+	// DefaultFramebuffer is used in place of framebuffer 0, so traces of on-screen rendering can be replayed into a framebuffer object
+	DefaultFramebuffer Framebuffer
+	// Frame is called at the end of each frame in the trace, if it is not nil. Returning an error stops the replay.
+	Frame func(frame int) error
+
+	gl        GL460
+	r         *bufio.Reader
+	cmds      []CommandID
+	frame     int
+	names     map[string]map[uint32]uint32
+	locations map[location]uint32
+	program   uint32 // The program in use, whose uniform locations are passed to Uniform commands
+	syncs     map[tracedSync]GLsync
+	frees     []func()
+
+	// Client arrays stay in use after the command that sets them up, until they are replaced or their vertex array object is deleted
+	retain        map[clientArray][]func()
+	vertexArray   uint32 // The bound vertex array object
+	clientTexture uint32 // The client active texture unit, which selects the texture coordinate array
+}
+
+// clientArray identifies client memory that is used after the command that passed it returns
+type clientArray struct {
+	vertexArray uint32 // The replayed vertex array object holding the array, or 0 for state outside vertex arrays
+	name        string // attrib for generic vertex attributes, otherwise the command that sets up the array
+	index       uint32 // The attribute index or texture unit
+}
+
+// location is a uniform location, vertex attribute location or uniform block index from the trace
+type location struct {
+	kind    string // uniform, attrib or uniform block
+	program uint32 // The replayed program the location belongs to, or 0 for attribute locations
+	traced  uint32
+}
+
+// NewReplayer returns a Replayer that executes commands on gl
+func NewReplayer(gl GL460) *Replayer {
+	return &Replayer{
+		gl:        gl,
+		names:     make(map[string]map[uint32]uint32),
+		locations: make(map[location]uint32),
+		syncs:     make(map[tracedSync]GLsync),
+		retain:    make(map[clientArray][]func()),
+	}
+}
+
+// Name returns the object name that replaces the given name from the trace.
+// Names are remapped as objects are created, so replaying a trace does not depend on the names chosen by the driver.
+func (r *Replayer) Name(class string, name uint32) uint32 {
+	if name == 0 {
+		if class == "framebuffer" {
+			return uint32(r.DefaultFramebuffer)
+		}
+		return 0
+	}
+	if mapped, ok := r.names[class][name]; ok {
+		return mapped
+	}
+	return name
+}
+
+func (r *Replayer) setName(class string, traced, actual uint32) {
+	if r.names[class] == nil {
+		r.names[class] = make(map[uint32]uint32)
+	}
+	r.names[class][traced] = actual
+}
+
+// Replay reads a trace from rd and executes it
+func (r *Replayer) Replay(rd io.Reader) error {
+	r.r = bufio.NewReader(rd)
+	magic := make([]byte, len(traceMagic))
+	if _, err := io.ReadFull(r.r, magic); err != nil || string(magic) != traceMagic {
+		return errTraceFormat
+	}
+	if version, err := r.readUint(4); err != nil {
+		return err
+	} else if version != TraceVersion {
+		return fmt.Errorf("Unsupported trace version %d", version)
+	}
+
+	for {
+		rec, err := r.r.ReadByte()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		switch rec {
+		case recDefine:
+			if err := r.define(); err != nil {
+				return err
+			}
+		case recCall:
+			if err := r.call(); err != nil {
+				return err
+			}
+		case recFrame:
+			r.frame++
+			if r.Frame != nil {
+				if err := r.Frame(r.frame); err != nil {
+					return err
+				}
+			}
+		default:
+			return errTraceFormat
+		}
+	}
+}
+
+func (r *Replayer) define() error {
+	idx, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return err
+	}
+	name, err := r.readString()
+	if err != nil {
+		return err
+	}
+	if idx != uint64(len(r.cmds)) {
+		return errTraceFormat
+	}
+	cmd, ok := commandsByName[name]
+	if !ok {
+		return fmt.Errorf("Unknown command in trace: %s", name)
+	}
+	r.cmds = append(r.cmds, cmd)
+	return nil
+}
+
+func (r *Replayer) call() error {
+	idx, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return err
+	}
+	if idx >= uint64(len(r.cmds)) {
+		return errTraceFormat
+	}
+	cmd := r.cmds[idx]
+	info := cmd.Info()
+
+	args := make([]Arg, len(info.Params))
+	var outputs []func()
+	for i := range info.Params {
+		arg, err := r.readArg(cmd, i)
+		if err != nil {
+			return err
+		}
+		args[i] = arg
+		if out := r.remapArg(info, args, i); out != nil {
+			outputs = append(outputs, out)
+		}
+	}
+	var ret Arg
+	if info.Return != "" {
+		if ret, err = r.readArg(cmd, -1); err != nil {
+			return err
+		}
+	}
+
+	if !queryCommand(info) {
+		actual := cmd.Call(r.gl, args)
+		for _, out := range outputs {
+			out()
+		}
+		r.remapReturn(info, args, ret, actual)
+		r.track(cmd, info, args)
+	}
+
+	r.release(info, args)
+	return nil
+}
+
+// release frees the memory allocated for the arguments of a command.
+// Commands that set up client arrays keep using the memory after they return, so it is instead retained until the array is replaced.
+func (r *Replayer) release(info *CommandInfo, args []Arg) {
+	if key, ok := r.clientArray(info, args); ok {
+		for _, free := range r.retain[key] {
+			free()
+		}
+		r.retain[key] = append([]func(){}, r.frees...)
+	} else {
+		for _, free := range r.frees {
+			free()
+		}
+	}
+	r.frees = r.frees[:0]
+}
+
+// track updates the replayed state that later commands depend on
+func (r *Replayer) track(cmd CommandID, info *CommandInfo, args []Arg) {
+	switch cmd {
+	case CmdUseProgram:
+		r.program, _ = objectName(args[0])
+	case CmdBindVertexArray, CmdBindVertexArrayAPPLE:
+		r.vertexArray, _ = objectName(args[0])
+	case CmdClientActiveTexture, CmdClientActiveTextureARB:
+		r.clientTexture = args[0].(uint32)
+	case CmdDeleteVertexArrays, CmdDeleteVertexArraysAPPLE:
+		// Deleting a vertex array object releases the client arrays it refers to
+		for _, array := range argNames(cmd, args, 1) {
+			if array == 0 {
+				continue
+			}
+			for key, frees := range r.retain {
+				if key.vertexArray == array {
+					for _, free := range frees {
+						free()
+					}
+					delete(r.retain, key)
+				}
+			}
+			if r.vertexArray == array {
+				r.vertexArray = 0
+			}
+		}
+	}
+}
+
+// clientArray returns the client array set up by a command, if any
+func (r *Replayer) clientArray(info *CommandInfo, args []Arg) (clientArray, bool) {
+	switch {
+	case info.Name == "glFeedbackBuffer" || info.Name == "glSelectBuffer":
+		return clientArray{name: info.Name}, true
+	case queryCommand(info) || !strings.Contains(info.Name, "Pointer"):
+		return clientArray{}, false
+	}
+	key := clientArray{vertexArray: r.vertexArray, name: info.Name}
+	if index, ok := argByName(info, args, "index"); ok {
+		// The generic attribute commands replace each other's arrays
+		key.name = "attrib"
+		key.index, _ = index.(uint32)
+	} else if strings.HasPrefix(info.Name, "glTexCoordPointer") {
+		key.index = r.clientTexture
+	}
+	return key, true
+}
+
+// remapArg replaces object names in argument i with their replayed equivalents.
+// If the argument is an array that the command fills with new names, it instead returns a function that records the new names.
+func (r *Replayer) remapArg(info *CommandInfo, args []Arg, i int) (output func()) {
+	if sync, ok := args[i].(tracedSync); ok {
+		args[i] = r.syncs[sync]
+		return nil
+	}
+	if kind := locationKind(info, i); kind != "" {
+		if traced, ok := locationBits(args[i]); ok {
+			if actual, ok := r.locations[r.location(kind, info, args, traced)]; ok {
+				args[i] = withName(args[i], actual)
+			}
+		}
+		return nil
+	}
+	class := info.Params[i].Class
+	if class == "" {
+		return nil
+	}
+	if name, ok := objectName(args[i]); ok {
+		args[i] = withName(args[i], r.Name(class, name))
+	} else if arg, ok := objectNames(args[i]); ok && arg != nil {
+		n, ok := info.ArgLen(args, i)
+		if !ok {
+			return nil
+		}
+		names := *(*[]uint32)(mkslice(uintptr(unsafe.Pointer(arg)), n))
+		if strings.HasPrefix(info.Name, "glGen") || strings.HasPrefix(info.Name, "glCreate") {
+			traced := append([]uint32(nil), names...)
+			return func() {
+				for j, name := range traced {
+					r.setName(class, name, names[j])
+				}
+			}
+		}
+		for j, name := range names {
+			names[j] = r.Name(class, name)
+		}
+	}
+	return nil
+}
+
+func (r *Replayer) remapReturn(info *CommandInfo, args []Arg, traced, actual Arg) {
+	if sync, ok := traced.(tracedSync); ok {
+		if sync != 0 {
+			r.syncs[sync] = actual.(GLsync)
+		}
+	} else if kind, ok := locationQueries[info.Name]; ok {
+		tracedLoc, _ := locationBits(traced)
+		actualLoc, _ := locationBits(actual)
+		r.locations[r.location(kind, info, args, tracedLoc)] = actualLoc
+	} else if name, ok := objectName(traced); ok && info.ReturnClass != "" {
+		actualName, _ := objectName(actual)
+		r.setName(info.ReturnClass, name, actualName)
+	}
+}
+
+// location returns the key of a location of the given kind passed to or returned by a command
+func (r *Replayer) location(kind string, info *CommandInfo, args []Arg, traced uint32) location {
+	loc := location{kind: kind, traced: traced}
+	if kind != "attrib" {
+		// Commands without a program parameter use the program in use
+		loc.program = r.program
+		if i, ok := argIndex(info, "program"); ok {
+			loc.program, _ = objectName(args[i])
+		}
+	}
+	return loc
+}
+
+// queryCommand reports whether a command is skipped during replay.
+// Queries have no effect on the GL state, and their outputs can't be sized reliably.
+// Queries for locations are replayed, so the locations chosen by the driver can be remapped.
+func queryCommand(info *CommandInfo) bool {
+	if _, ok := locationQueries[info.Name]; ok {
+		return false
+	}
+	return strings.HasPrefix(info.Name, "glGet") || strings.HasPrefix(info.Name, "glIs")
+}
+
+// locationQueries holds the kind of location returned by each query for one
+var locationQueries = map[string]string{
+	"glGetUniformLocation":   "uniform",
+	"glGetAttribLocation":    "attrib",
+	"glGetUniformBlockIndex": "uniform block",
+}
+
+// locationKind returns the kind of location passed as argument i, or "" if it is not a location
+func locationKind(info *CommandInfo, i int) string {
+	name := info.Params[i].Name
+	switch {
+	case name == "location" && (strings.HasPrefix(info.Name, "glUniform") || strings.HasPrefix(info.Name, "glProgramUniform")):
+		return "uniform"
+	case name == "uniformBlockIndex":
+		return "uniform block"
+	case (name == "index" || name == "attribindex") && (strings.Contains(info.Name, "VertexAttrib") || strings.Contains(info.Name, "VertexArrayAttrib")):
+		return "attrib"
+	}
+	return ""
+}
+
+// locationBits returns the bits of a location, which is a GLint or, for uniform block indices, a GLuint
+func locationBits(arg Arg) (uint32, bool) {
+	switch arg := arg.(type) {
+	case int32:
+		return uint32(arg), true
+	case uint32:
+		return arg, true
+	}
+	return 0, false
+}
+
+// bufferBound reports whether a buffer is bound to binding, which is 0 for pointers that never refer to a buffer
+func (r *Replayer) bufferBound(binding uint32) bool {
+	if binding == 0 {
+		return false
+	}
+	var buffer int32
+	r.gl.GetIntegerv(binding, &buffer)
+	return buffer != 0
+}
+
+// tracedSync is the address of a sync object in the trace. It is replaced by the corresponding replayed sync object before use.
+type tracedSync uint64
+
+// readArg reads argument i of cmd, or its return value if i is negative
+func (r *Replayer) readArg(cmd CommandID, i int) (Arg, error) {
+	info := cmd.Info()
+	typeName := info.Return
+	if i >= 0 {
+		typeName = info.Params[i].Type
+	}
+	t := ArgType(typeName)
+	if t == reflect.TypeOf(GLsync(nil)) {
+		_, addr, err := r.readTag()
+		return tracedSync(addr), err
+	}
+
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Func:
+		v = reflect.MakeFunc(t, func([]reflect.Value) []reflect.Value { return nil })
+	case reflect.Bool:
+		b, err := r.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		v.SetBool(b != 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := r.readUint(int(t.Size()))
+		if err != nil {
+			return nil, err
+		}
+		// Sign extend
+		shift := 64 - 8*t.Size()
+		v.SetInt(int64(n<<shift) >> shift)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := r.readUint(int(t.Size()))
+		if err != nil {
+			return nil, err
+		}
+		v.SetUint(n)
+	case reflect.Float32:
+		n, err := r.readUint(4)
+		if err != nil {
+			return nil, err
+		}
+		v.SetFloat(float64(math.Float32frombits(uint32(n))))
+	case reflect.Float64:
+		n, err := r.readUint(8)
+		if err != nil {
+			return nil, err
+		}
+		v.SetFloat(math.Float64frombits(n))
+	case reflect.Ptr, reflect.UnsafePointer:
+		p, err := r.readPointer(cmd, i)
+		if err != nil {
+			return nil, err
+		}
+		if t.Kind() == reflect.Ptr {
+			v = reflect.NewAt(t.Elem(), p)
+		} else {
+			v = reflect.ValueOf(p)
+		}
+	default:
+		return nil, fmt.Errorf("Cannot replay argument of type %s", t)
+	}
+	return v.Interface(), nil
+}
+
+func (r *Replayer) readPointer(cmd CommandID, i int) (unsafe.Pointer, error) {
+	tag, addr, err := r.readTag()
+	if err != nil {
+		return nil, err
+	}
+	switch tag {
+	case ptrNil:
+		return nil, nil
+	case ptrAddr:
+		// An address is only meaningful as an offset into a bound buffer
+		if i >= 0 && !queryCommand(cmd.Info()) && !r.bufferBound(PointerBinding(cmd, i)) {
+			info := cmd.Info()
+			return nil, fmt.Errorf("%s: %s points to client memory that was not captured", info.Name, info.Params[i].Name)
+		}
+		return Offset(int(addr)), nil
+
+	case ptrMem:
+		data, err := r.readString()
+		if err != nil {
+			return nil, err
+		}
+		// Use C memory, as the GL may keep the pointer
+		mem := C.malloc(C.size_t(len(data) + 1))
+		if len(data) > 0 {
+			C.memcpy(mem, unsafe.Pointer(&[]byte(data)[0]), C.size_t(len(data)))
+		}
+		r.frees = append(r.frees, func() { C.free(mem) })
+		return mem, nil
+
+	case ptrStrs:
+		n, err := binary.ReadUvarint(r.r)
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		strs := make([]string, n)
+		for i := range strs {
+			if strs[i], err = r.readString(); err != nil {
+				return nil, err
+			}
+		}
+		strp, _, free := Strs(strs...)
+		r.frees = append(r.frees, free)
+		return unsafe.Pointer(strp), nil
+
+	default:
+		return nil, errTraceFormat
+	}
+}
+
+// readTag reads a pointer tag, and the address that follows it if there is one
+func (r *Replayer) readTag() (tag byte, addr uint64, err error) {
+	tag, err = r.r.ReadByte()
+	if err != nil || tag == ptrNil || tag == ptrStrs {
+		return tag, 0, err
+	}
+	addr, err = r.readUint(8)
+	return tag, addr, err
+}
+
+func (r *Replayer) readUint(size int) (uint64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(r.r, b[:size]); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint64(b[:]), nil
+}
+
+func (r *Replayer) readString() (string, error) {
+	n, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(r.r, b)
+	return string(b), err
+}
+
+// CommandByName returns the command with the given name, eg. "glDrawArrays"
+func CommandByName(name string) (CommandID, bool) {
+	cmd, ok := commandsByName[name]
+	return cmd, ok
+}
+
+var commandsByName = func() map[string]CommandID {
+	cmds := make(map[string]CommandID, len(commandInfo))
+	for i, info := range commandInfo {
+		cmds[info.Name] = CommandID(i)
+	}
+	return cmds
+}()
+
+var argTypes = map[string]reflect.Type{
+	"int8":           reflect.TypeOf(int8(0)),
+	"int16":          reflect.TypeOf(int16(0)),
+	"int32":          reflect.TypeOf(int32(0)),
+	"int64":          reflect.TypeOf(int64(0)),
+	"int":            reflect.TypeOf(int(0)),
+	"uint8":          reflect.TypeOf(uint8(0)),
+	"uint16":         reflect.TypeOf(uint16(0)),
+	"uint32":         reflect.TypeOf(uint32(0)),
+	"uint64":         reflect.TypeOf(uint64(0)),
+	"uint":           reflect.TypeOf(uint(0)),
+	"uintptr":        reflect.TypeOf(uintptr(0)),
+	"float32":        reflect.TypeOf(float32(0)),
+	"float64":        reflect.TypeOf(float64(0)),
+	"bool":           reflect.TypeOf(false),
+	"unsafe.Pointer": reflect.TypeOf(unsafe.Pointer(nil)),
+	"GLhandleARB":    reflect.TypeOf(GLhandleARB(0)),
+	"GLsync":         reflect.TypeOf(GLsync(nil)),
+
+	"Buffer":      reflect.TypeOf(Buffer(0)),
+	"Framebuffer": reflect.TypeOf(Framebuffer(0)),
+	"Program":     reflect.TypeOf(Program(0)),
+	"Query":       reflect.TypeOf(Query(0)),
+	"Sampler":     reflect.TypeOf(Sampler(0)),
+	"Shader":      reflect.TypeOf(Shader(0)),
+	"Texture":     reflect.TypeOf(Texture(0)),
+	"VertexArray": reflect.TypeOf(VertexArray(0)),
+
+	"GLeglImageOES":        reflect.TypeOf(GLeglImageOES(nil)),
+	"GLeglClientBufferEXT": reflect.TypeOf(GLeglClientBufferEXT(nil)),
+	"CLContext":            reflect.TypeOf((*CLContext)(nil)).Elem(),
+	"CLEvent":              reflect.TypeOf((*CLEvent)(nil)).Elem(),
+	"GLVULKANPROCNV":       reflect.TypeOf(GLVULKANPROCNV(nil)),
+
+	"func(source, type_, id, severity uint32, message string)": reflect.TypeOf(func(source, type_, id, severity uint32, message string) {}),
+}
+
+// ArgType returns the type described by the Go type of a parameter or return value, as given by ParamInfo or CommandInfo
+func ArgType(name string) reflect.Type {
+	ptrs := 0
+	for strings.HasPrefix(name, "*") {
+		name = name[1:]
+		ptrs++
+	}
+	t, ok := argTypes[name]
+	if !ok {
+		panic("Unknown argument type: " + name)
+	}
+	for ; ptrs > 0; ptrs-- {
+		t = reflect.PtrTo(t)
+	}
+	return t
+}
@@ -0,0 +1,45 @@
+package gll_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/vktec/gll"
+	"github.com/vktec/gll/glfake"
+)
+
+func TestReplayLocations(t *testing.T) {
+	captured := glfake.New()
+	captured.Return(gll.CmdGetUniformLocation, int32(3))
+	captured.Return(gll.CmdGetAttribLocation, int32(1))
+	buf := &bytes.Buffer{}
+	gl := gll.Capture(captured, buf)
+
+	prog := gl.CreateProgram()
+	gl.UseProgram(prog)
+	loc := gl.GetUniformLocation(prog, gll.Str("color\x00"))
+	gl.Uniform1f(loc, 0.5)
+	gl.ProgramUniform1f(prog, loc, 0.5)
+	attrib := uint32(gl.GetAttribLocation(prog, gll.Str("position\x00")))
+	gl.EnableVertexAttribArray(attrib)
+	if err := gl.FlushTrace(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The driver used for replay chooses different locations
+	replayed := glfake.New()
+	replayed.Return(gll.CmdGetUniformLocation, int32(7))
+	replayed.Return(gll.CmdGetAttribLocation, int32(4))
+	if err := gll.NewReplayer(replayed).Replay(buf); err != nil {
+		t.Fatal(err)
+	}
+	replayed.Expect(t,
+		"glCreateProgram() = 1",
+		"glUseProgram(1)",
+		`glGetUniformLocation(1, "color") = 7`,
+		"glUniform1f(7, 0.5)",
+		"glProgramUniform1f(1, 7, 0.5)",
+		`glGetAttribLocation(1, "position") = 4`,
+		"glEnableVertexAttribArray(4)",
+	)
+}
@@ -0,0 +1,516 @@
+package gll
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unsafe"
+)
+
+// State is a snapshot of the GL state, as returned by Snapshot.
+// Enum values are stored as their registry names. Object names are stored as numbers, with 0 meaning no object.
+type State struct {
+	Version       string
+	Bindings      map[string]uint32 // Object bindings, by query parameter name, eg. "GL_ARRAY_BUFFER_BINDING"
+	ActiveTexture string
+	Capabilities  map[string]bool
+
+	Viewport   [4]int32
+	Scissor    [4]int32
+	ClearColor [4]float32
+	ColorMask  [4]bool
+
+	Blend      BlendState
+	Depth      DepthState
+	Stencil    [2]StencilState // Front and back
+	Rasterizer RasterizerState
+
+	VertexAttribs []VertexAttribState
+	TextureUnits  map[int]TextureUnitState // Texture units with at least one texture or sampler bound, by index
+	Uniforms      []UniformState           // Uniforms of the current program outside of uniform blocks
+}
+
+// BlendState holds the blend function and equation of draw buffer 0
+type BlendState struct {
+	SrcRGB, DstRGB, SrcAlpha, DstAlpha string
+	EquationRGB, EquationAlpha         string
+	Color                              [4]float32
+}
+
+type DepthState struct {
+	Func       string
+	WriteMask  bool
+	Range      [2]float32
+	ClearValue float32
+}
+
+type StencilState struct {
+	Func                               string
+	Ref                                int32
+	ValueMask, WriteMask               uint32
+	Fail, PassDepthFail, PassDepthPass string
+}
+
+type RasterizerState struct {
+	CullFace            string
+	FrontFace           string
+	PolygonMode         string
+	LineWidth           float32
+	PointSize           float32
+	PolygonOffsetFactor float32
+	PolygonOffsetUnits  float32
+}
+
+type VertexAttribState struct {
+	Enabled    bool
+	Size       int32
+	Type       string
+	Normalized bool
+	Integer    bool
+	Stride     int32
+	Divisor    uint32
+	Buffer     uint32
+	Offset     uintptr // The pointer passed to VertexAttribPointer, which is an offset into Buffer if it is not 0
+}
+
+type TextureUnitState struct {
+	Textures map[string]uint32 // Texture bindings, by target name, eg. "GL_TEXTURE_2D"
+	Sampler  uint32
+}
+
+type UniformState struct {
+	Name     string
+	Type     string
+	Location int32
+	Value    []float64 // The components of every element, in order
+}
+
+// Snapshot queries the GL state. gl must support OpenGL 3.3 or later; state introduced by later versions is only queried if supported.
+// The active texture unit is changed while the texture units are queried, and restored afterwards.
+func Snapshot(gl GL460) *State {
+	s := &State{
+		Version:      GoStr(gl.GetString(VERSION)),
+		Bindings:     make(map[string]uint32),
+		Capabilities: make(map[string]bool),
+		TextureUnits: make(map[int]TextureUnitState),
+	}
+	var major, minor int
+	fmt.Sscanf(s.Version, "%d.%d", &major, &minor)
+	version := major*10 + minor
+
+	geti := func(pname uint32) int32 {
+		var v int32
+		gl.GetIntegerv(pname, &v)
+		return v
+	}
+	getf := func(pname uint32) float32 {
+		var v float32
+		gl.GetFloatv(pname, &v)
+		return v
+	}
+	getb := func(pname uint32) bool {
+		var v bool
+		gl.GetBooleanv(pname, &v)
+		return v
+	}
+	enum := func(group string, pname uint32) string {
+		return stateEnum(group, uint32(geti(pname)))
+	}
+
+	for _, b := range stateBindings {
+		if version >= b.since {
+			s.Bindings[b.name] = uint32(geti(b.pname))
+		}
+	}
+	activeTexture := uint32(geti(ACTIVE_TEXTURE))
+	s.ActiveTexture = stateEnum("TextureUnit", activeTexture)
+	for _, c := range stateCapabilities {
+		if version >= c.since {
+			s.Capabilities[c.name] = gl.IsEnabled(c.pname)
+		}
+	}
+
+	gl.GetIntegerv(VIEWPORT, &s.Viewport[0])
+	gl.GetIntegerv(SCISSOR_BOX, &s.Scissor[0])
+	gl.GetFloatv(COLOR_CLEAR_VALUE, &s.ClearColor[0])
+	gl.GetBooleanv(COLOR_WRITEMASK, &s.ColorMask[0])
+
+	s.Blend = BlendState{
+		SrcRGB:        enum("BlendingFactor", BLEND_SRC_RGB),
+		DstRGB:        enum("BlendingFactor", BLEND_DST_RGB),
+		SrcAlpha:      enum("BlendingFactor", BLEND_SRC_ALPHA),
+		DstAlpha:      enum("BlendingFactor", BLEND_DST_ALPHA),
+		EquationRGB:   enum("BlendEquationModeEXT", BLEND_EQUATION_RGB),
+		EquationAlpha: enum("BlendEquationModeEXT", BLEND_EQUATION_ALPHA),
+	}
+	gl.GetFloatv(BLEND_COLOR, &s.Blend.Color[0])
+
+	s.Depth = DepthState{
+		Func:       enum("DepthFunction", DEPTH_FUNC),
+		WriteMask:  getb(DEPTH_WRITEMASK),
+		ClearValue: getf(DEPTH_CLEAR_VALUE),
+	}
+	gl.GetFloatv(DEPTH_RANGE, &s.Depth.Range[0])
+
+	s.Stencil[0] = StencilState{
+		Func:          enum("StencilFunction", STENCIL_FUNC),
+		Ref:           geti(STENCIL_REF),
+		ValueMask:     uint32(geti(STENCIL_VALUE_MASK)),
+		WriteMask:     uint32(geti(STENCIL_WRITEMASK)),
+		Fail:          enum("StencilOp", STENCIL_FAIL),
+		PassDepthFail: enum("StencilOp", STENCIL_PASS_DEPTH_FAIL),
+		PassDepthPass: enum("StencilOp", STENCIL_PASS_DEPTH_PASS),
+	}
+	s.Stencil[1] = StencilState{
+		Func:          enum("StencilFunction", STENCIL_BACK_FUNC),
+		Ref:           geti(STENCIL_BACK_REF),
+		ValueMask:     uint32(geti(STENCIL_BACK_VALUE_MASK)),
+		WriteMask:     uint32(geti(STENCIL_BACK_WRITEMASK)),
+		Fail:          enum("StencilOp", STENCIL_BACK_FAIL),
+		PassDepthFail: enum("StencilOp", STENCIL_BACK_PASS_DEPTH_FAIL),
+		PassDepthPass: enum("StencilOp", STENCIL_BACK_PASS_DEPTH_PASS),
+	}
+
+	// Compatibility contexts return separate front and back polygon modes
+	var polygonMode [2]int32
+	gl.GetIntegerv(POLYGON_MODE, &polygonMode[0])
+	s.Rasterizer = RasterizerState{
+		CullFace:            enum("CullFaceMode", CULL_FACE_MODE),
+		FrontFace:           enum("FrontFaceDirection", FRONT_FACE),
+		PolygonMode:         stateEnum("PolygonMode", uint32(polygonMode[0])),
+		LineWidth:           getf(LINE_WIDTH),
+		PointSize:           getf(POINT_SIZE),
+		PolygonOffsetFactor: getf(POLYGON_OFFSET_FACTOR),
+		PolygonOffsetUnits:  getf(POLYGON_OFFSET_UNITS),
+	}
+
+	s.VertexAttribs = make([]VertexAttribState, geti(MAX_VERTEX_ATTRIBS))
+	for i := range s.VertexAttribs {
+		index := uint32(i)
+		attrib := func(pname uint32) int32 {
+			var v int32
+			gl.GetVertexAttribiv(index, pname, &v)
+			return v
+		}
+		var ptr unsafe.Pointer
+		gl.GetVertexAttribPointerv(index, VERTEX_ATTRIB_ARRAY_POINTER, &ptr)
+		s.VertexAttribs[i] = VertexAttribState{
+			Enabled:    attrib(VERTEX_ATTRIB_ARRAY_ENABLED) != 0,
+			Size:       attrib(VERTEX_ATTRIB_ARRAY_SIZE),
+			Type:       stateEnum("VertexAttribPointerType", uint32(attrib(VERTEX_ATTRIB_ARRAY_TYPE))),
+			Normalized: attrib(VERTEX_ATTRIB_ARRAY_NORMALIZED) != 0,
+			Integer:    attrib(VERTEX_ATTRIB_ARRAY_INTEGER) != 0,
+			Stride:     attrib(VERTEX_ATTRIB_ARRAY_STRIDE),
+			Divisor:    uint32(attrib(VERTEX_ATTRIB_ARRAY_DIVISOR)),
+			Buffer:     uint32(attrib(VERTEX_ATTRIB_ARRAY_BUFFER_BINDING)),
+			Offset:     uintptr(ptr),
+		}
+	}
+
+	units := int(geti(MAX_COMBINED_TEXTURE_IMAGE_UNITS))
+	for i := 0; i < units; i++ {
+		gl.ActiveTexture(TEXTURE0 + uint32(i))
+		unit := TextureUnitState{Textures: make(map[string]uint32)}
+		bound := false
+		for _, b := range stateTextureBindings {
+			if version < b.since {
+				continue
+			}
+			if tex := uint32(geti(b.pname)); tex != 0 {
+				unit.Textures[b.name] = tex
+				bound = true
+			}
+		}
+		unit.Sampler = uint32(geti(SAMPLER_BINDING))
+		if bound || unit.Sampler != 0 {
+			s.TextureUnits[i] = unit
+		}
+	}
+	gl.ActiveTexture(activeTexture)
+
+	if program := Program(geti(CURRENT_PROGRAM)); program != 0 {
+		s.Uniforms = snapshotUniforms(gl, program)
+	}
+	return s
+}
+
+func snapshotUniforms(gl GL460, program Program) []UniformState {
+	var count, maxLen int32
+	gl.GetProgramiv(program, ACTIVE_UNIFORMS, &count)
+	gl.GetProgramiv(program, ACTIVE_UNIFORM_MAX_LENGTH, &maxLen)
+	if maxLen == 0 {
+		return nil
+	}
+	uniforms := []UniformState{}
+	nameBuf := make([]uint8, maxLen)
+	for i := uint32(0); i < uint32(count); i++ {
+		var length, size int32
+		var type_ uint32
+		gl.GetActiveUniform(program, i, maxLen, &length, &size, &type_, &nameBuf[0])
+		name := string(nameBuf[:length])
+		location := gl.GetUniformLocation(program, Str(name+"\x00"))
+		if location < 0 {
+			// Uniform block members have no location; their values are in buffers
+			continue
+		}
+
+		u := UniformState{Name: name, Type: stateEnum("UniformType", type_), Location: location}
+		kind, n := uniformKind(type_)
+		base := strings.TrimSuffix(name, "[0]")
+		for elem := int32(0); elem < size; elem++ {
+			loc := location
+			if elem > 0 {
+				loc = gl.GetUniformLocation(program, Str(base+"["+strconv.Itoa(int(elem))+"]\x00"))
+			}
+			switch kind {
+			case 'f':
+				v := make([]float32, n)
+				gl.GetUniformfv(program, loc, &v[0])
+				for _, x := range v {
+					u.Value = append(u.Value, float64(x))
+				}
+			case 'd':
+				v := make([]float64, n)
+				gl.GetUniformdv(program, loc, &v[0])
+				u.Value = append(u.Value, v...)
+			case 'u':
+				v := make([]uint32, n)
+				gl.GetUniformuiv(program, loc, &v[0])
+				for _, x := range v {
+					u.Value = append(u.Value, float64(x))
+				}
+			default:
+				v := make([]int32, n)
+				gl.GetUniformiv(program, loc, &v[0])
+				for _, x := range v {
+					u.Value = append(u.Value, float64(x))
+				}
+			}
+		}
+		uniforms = append(uniforms, u)
+	}
+	return uniforms
+}
+
+// uniformKind returns the component type ('f', 'd', 'u' or 'i') and the number of components of a uniform type.
+// Booleans, samplers and images are queried as integers.
+func uniformKind(type_ uint32) (kind byte, n int) {
+	switch type_ {
+	case FLOAT:
+		return 'f', 1
+	case FLOAT_VEC2:
+		return 'f', 2
+	case FLOAT_VEC3:
+		return 'f', 3
+	case FLOAT_VEC4, FLOAT_MAT2:
+		return 'f', 4
+	case FLOAT_MAT2x3, FLOAT_MAT3x2:
+		return 'f', 6
+	case FLOAT_MAT2x4, FLOAT_MAT4x2:
+		return 'f', 8
+	case FLOAT_MAT3:
+		return 'f', 9
+	case FLOAT_MAT3x4, FLOAT_MAT4x3:
+		return 'f', 12
+	case FLOAT_MAT4:
+		return 'f', 16
+	case DOUBLE:
+		return 'd', 1
+	case DOUBLE_VEC2:
+		return 'd', 2
+	case DOUBLE_VEC3:
+		return 'd', 3
+	case DOUBLE_VEC4, DOUBLE_MAT2:
+		return 'd', 4
+	case DOUBLE_MAT2x3, DOUBLE_MAT3x2:
+		return 'd', 6
+	case DOUBLE_MAT2x4, DOUBLE_MAT4x2:
+		return 'd', 8
+	case DOUBLE_MAT3:
+		return 'd', 9
+	case DOUBLE_MAT3x4, DOUBLE_MAT4x3:
+		return 'd', 12
+	case DOUBLE_MAT4:
+		return 'd', 16
+	case UNSIGNED_INT:
+		return 'u', 1
+	case UNSIGNED_INT_VEC2:
+		return 'u', 2
+	case UNSIGNED_INT_VEC3:
+		return 'u', 3
+	case UNSIGNED_INT_VEC4:
+		return 'u', 4
+	case INT_VEC2, BOOL_VEC2:
+		return 'i', 2
+	case INT_VEC3, BOOL_VEC3:
+		return 'i', 3
+	case INT_VEC4, BOOL_VEC4:
+		return 'i', 4
+	default:
+		return 'i', 1
+	}
+}
+
+func stateEnum(group string, value uint32) string {
+	if name := EnumName(group, value); name != "" {
+		return name
+	}
+	return fmt.Sprintf("%#x", value)
+}
+
+// JSON returns the state encoded as indented JSON
+func (s *State) JSON() []byte {
+	data, err := json.MarshalIndent(s, "", "\t")
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// Diff returns a description of every difference between s and other, one per line, in the form
+//
+//	Blend.SrcRGB: "GL_ONE" -> "GL_SRC_ALPHA"
+//
+// where the path is that of the value in the JSON encoding of the state.
+func (s *State) Diff(other *State) []string {
+	var a, b interface{}
+	json.Unmarshal(s.JSON(), &a)
+	json.Unmarshal(other.JSON(), &b)
+	diffs := []string{}
+	diffJSON(&diffs, "", a, b)
+	return diffs
+}
+
+func diffJSON(diffs *[]string, path string, a, b interface{}) {
+	switch a := a.(type) {
+	case map[string]interface{}:
+		if b, ok := b.(map[string]interface{}); ok {
+			keys := []string{}
+			for k := range a {
+				keys = append(keys, k)
+			}
+			for k := range b {
+				if _, ok := a[k]; !ok {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				sub := k
+				if path != "" {
+					sub = path + "." + k
+				}
+				diffJSON(diffs, sub, a[k], b[k])
+			}
+			return
+		}
+	case []interface{}:
+		if b, ok := b.([]interface{}); ok {
+			n := len(a)
+			if len(b) > n {
+				n = len(b)
+			}
+			for i := 0; i < n; i++ {
+				var ai, bi interface{}
+				if i < len(a) {
+					ai = a[i]
+				}
+				if i < len(b) {
+					bi = b[i]
+				}
+				diffJSON(diffs, fmt.Sprintf("%s[%d]", path, i), ai, bi)
+			}
+			return
+		}
+	}
+
+	aj, _ := json.Marshal(a)
+	bj, _ := json.Marshal(b)
+	if string(aj) != string(bj) {
+		*diffs = append(*diffs, fmt.Sprintf("%s: %s -> %s", path, aj, bj))
+	}
+}
+
+type stateQuery struct {
+	name  string
+	pname uint32
+	since int // The GL version that introduced the state, eg. 43 for 4.3
+}
+
+var stateBindings = []stateQuery{
+	{"GL_ARRAY_BUFFER_BINDING", ARRAY_BUFFER_BINDING, 33},
+	{"GL_ATOMIC_COUNTER_BUFFER_BINDING", ATOMIC_COUNTER_BUFFER_BINDING, 42},
+	{"GL_COPY_READ_BUFFER_BINDING", COPY_READ_BUFFER_BINDING, 33},
+	{"GL_COPY_WRITE_BUFFER_BINDING", COPY_WRITE_BUFFER_BINDING, 33},
+	{"GL_DISPATCH_INDIRECT_BUFFER_BINDING", DISPATCH_INDIRECT_BUFFER_BINDING, 43},
+	{"GL_DRAW_INDIRECT_BUFFER_BINDING", DRAW_INDIRECT_BUFFER_BINDING, 40},
+	{"GL_ELEMENT_ARRAY_BUFFER_BINDING", ELEMENT_ARRAY_BUFFER_BINDING, 33},
+	{"GL_PARAMETER_BUFFER_BINDING", PARAMETER_BUFFER_BINDING, 46},
+	{"GL_PIXEL_PACK_BUFFER_BINDING", PIXEL_PACK_BUFFER_BINDING, 33},
+	{"GL_PIXEL_UNPACK_BUFFER_BINDING", PIXEL_UNPACK_BUFFER_BINDING, 33},
+	{"GL_QUERY_BUFFER_BINDING", QUERY_BUFFER_BINDING, 44},
+	{"GL_SHADER_STORAGE_BUFFER_BINDING", SHADER_STORAGE_BUFFER_BINDING, 43},
+	{"GL_TRANSFORM_FEEDBACK_BUFFER_BINDING", TRANSFORM_FEEDBACK_BUFFER_BINDING, 33},
+	{"GL_UNIFORM_BUFFER_BINDING", UNIFORM_BUFFER_BINDING, 33},
+	{"GL_DRAW_FRAMEBUFFER_BINDING", DRAW_FRAMEBUFFER_BINDING, 33},
+	{"GL_READ_FRAMEBUFFER_BINDING", READ_FRAMEBUFFER_BINDING, 33},
+	{"GL_RENDERBUFFER_BINDING", RENDERBUFFER_BINDING, 33},
+	{"GL_VERTEX_ARRAY_BINDING", VERTEX_ARRAY_BINDING, 33},
+	{"GL_CURRENT_PROGRAM", CURRENT_PROGRAM, 33},
+	{"GL_PROGRAM_PIPELINE_BINDING", PROGRAM_PIPELINE_BINDING, 41},
+	{"GL_TRANSFORM_FEEDBACK_BINDING", TRANSFORM_FEEDBACK_BINDING, 40},
+}
+
+var stateCapabilities = []stateQuery{
+	{"GL_BLEND", BLEND, 33},
+	{"GL_CLIP_DISTANCE0", CLIP_DISTANCE0, 33},
+	{"GL_CLIP_DISTANCE1", CLIP_DISTANCE1, 33},
+	{"GL_CLIP_DISTANCE2", CLIP_DISTANCE2, 33},
+	{"GL_CLIP_DISTANCE3", CLIP_DISTANCE3, 33},
+	{"GL_CLIP_DISTANCE4", CLIP_DISTANCE4, 33},
+	{"GL_CLIP_DISTANCE5", CLIP_DISTANCE5, 33},
+	{"GL_CLIP_DISTANCE6", CLIP_DISTANCE6, 33},
+	{"GL_CLIP_DISTANCE7", CLIP_DISTANCE7, 33},
+	{"GL_COLOR_LOGIC_OP", COLOR_LOGIC_OP, 33},
+	{"GL_CULL_FACE", CULL_FACE, 33},
+	{"GL_DEBUG_OUTPUT", DEBUG_OUTPUT, 43},
+	{"GL_DEBUG_OUTPUT_SYNCHRONOUS", DEBUG_OUTPUT_SYNCHRONOUS, 43},
+	{"GL_DEPTH_CLAMP", DEPTH_CLAMP, 33},
+	{"GL_DEPTH_TEST", DEPTH_TEST, 33},
+	{"GL_DITHER", DITHER, 33},
+	{"GL_FRAMEBUFFER_SRGB", FRAMEBUFFER_SRGB, 33},
+	{"GL_LINE_SMOOTH", LINE_SMOOTH, 33},
+	{"GL_MULTISAMPLE", MULTISAMPLE, 33},
+	{"GL_POLYGON_OFFSET_FILL", POLYGON_OFFSET_FILL, 33},
+	{"GL_POLYGON_OFFSET_LINE", POLYGON_OFFSET_LINE, 33},
+	{"GL_POLYGON_OFFSET_POINT", POLYGON_OFFSET_POINT, 33},
+	{"GL_POLYGON_SMOOTH", POLYGON_SMOOTH, 33},
+	{"GL_PRIMITIVE_RESTART", PRIMITIVE_RESTART, 33},
+	{"GL_PRIMITIVE_RESTART_FIXED_INDEX", PRIMITIVE_RESTART_FIXED_INDEX, 43},
+	{"GL_PROGRAM_POINT_SIZE", PROGRAM_POINT_SIZE, 33},
+	{"GL_RASTERIZER_DISCARD", RASTERIZER_DISCARD, 33},
+	{"GL_SAMPLE_ALPHA_TO_COVERAGE", SAMPLE_ALPHA_TO_COVERAGE, 33},
+	{"GL_SAMPLE_ALPHA_TO_ONE", SAMPLE_ALPHA_TO_ONE, 33},
+	{"GL_SAMPLE_COVERAGE", SAMPLE_COVERAGE, 33},
+	{"GL_SAMPLE_MASK", SAMPLE_MASK, 33},
+	{"GL_SAMPLE_SHADING", SAMPLE_SHADING, 40},
+	{"GL_SCISSOR_TEST", SCISSOR_TEST, 33},
+	{"GL_STENCIL_TEST", STENCIL_TEST, 33},
+	{"GL_TEXTURE_CUBE_MAP_SEAMLESS", TEXTURE_CUBE_MAP_SEAMLESS, 33},
+}
+
+var stateTextureBindings = []stateQuery{
+	{"GL_TEXTURE_1D", TEXTURE_BINDING_1D, 33},
+	{"GL_TEXTURE_2D", TEXTURE_BINDING_2D, 33},
+	{"GL_TEXTURE_3D", TEXTURE_BINDING_3D, 33},
+	{"GL_TEXTURE_1D_ARRAY", TEXTURE_BINDING_1D_ARRAY, 33},
+	{"GL_TEXTURE_2D_ARRAY", TEXTURE_BINDING_2D_ARRAY, 33},
+	{"GL_TEXTURE_RECTANGLE", TEXTURE_BINDING_RECTANGLE, 33},
+	{"GL_TEXTURE_CUBE_MAP", TEXTURE_BINDING_CUBE_MAP, 33},
+	{"GL_TEXTURE_CUBE_MAP_ARRAY", TEXTURE_BINDING_CUBE_MAP_ARRAY, 40},
+	{"GL_TEXTURE_BUFFER", TEXTURE_BINDING_BUFFER, 33},
+	{"GL_TEXTURE_2D_MULTISAMPLE", TEXTURE_BINDING_2D_MULTISAMPLE, 33},
+	{"GL_TEXTURE_2D_MULTISAMPLE_ARRAY", TEXTURE_BINDING_2D_MULTISAMPLE_ARRAY, 33},
+}
@@ -0,0 +1,98 @@
+package gll
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/vktec/gll/internal/headless"
+)
+
+const stateTestVS = `#version 330 core
+uniform vec4 offset;
+uniform int flags[2];
+void main() { gl_Position = offset + vec4(flags[0], flags[1], 0, 1); }
+` + "\x00"
+
+const stateTestFS = `#version 330 core
+out vec4 color;
+void main() { color = vec4(1); }
+` + "\x00"
+
+func TestSnapshot(t *testing.T) {
+	ctx, err := headless.New(4, 5)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ctx.Destroy()
+	gl := New460(ctx.GetProcAddress)
+
+	prog := gl.CreateProgram()
+	for _, src := range []struct {
+		type_ uint32
+		code  string
+	}{{VERTEX_SHADER, stateTestVS}, {FRAGMENT_SHADER, stateTestFS}} {
+		shader := gl.CreateShader(src.type_)
+		strp := Str(src.code)
+		gl.ShaderSource(shader, 1, &strp, nil)
+		gl.CompileShader(shader)
+		gl.AttachShader(prog, shader)
+	}
+	gl.LinkProgram(prog)
+	gl.UseProgram(prog)
+	gl.Uniform4f(gl.GetUniformLocation(prog, Str("offset\x00")), 1, 2, 3, 4)
+	gl.Uniform1i(gl.GetUniformLocation(prog, Str("flags[1]\x00")), 7)
+
+	var tex Texture
+	gl.GenTextures(1, &tex)
+	gl.ActiveTexture(TEXTURE3)
+	gl.BindTexture(TEXTURE_2D, tex)
+	gl.ActiveTexture(TEXTURE0)
+
+	good := Snapshot(gl)
+	if good.TextureUnits[3].Textures["GL_TEXTURE_2D"] != uint32(tex) {
+		t.Errorf("Texture binding not recorded: %v", good.TextureUnits)
+	}
+	if good.ActiveTexture != "GL_TEXTURE0" {
+		t.Errorf("Active texture not restored: %s", good.ActiveTexture)
+	}
+	uniforms := []UniformState{
+		{"flags[0]", "GL_INT", gl.GetUniformLocation(prog, Str("flags\x00")), []float64{0, 7}},
+		{"offset", "GL_FLOAT_VEC4", gl.GetUniformLocation(prog, Str("offset\x00")), []float64{1, 2, 3, 4}},
+	}
+	if len(good.Uniforms) == 2 && good.Uniforms[0].Name == "offset" {
+		good.Uniforms[0], good.Uniforms[1] = good.Uniforms[1], good.Uniforms[0]
+	}
+	if !reflect.DeepEqual(good.Uniforms, uniforms) {
+		t.Errorf("Incorrect uniforms: expected %v, got %v", uniforms, good.Uniforms)
+	}
+
+	var decoded State
+	if err := json.Unmarshal(good.JSON(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if diffs := good.Diff(&decoded); len(diffs) != 0 {
+		t.Errorf("State changed by JSON round trip: %v", diffs)
+	}
+
+	gl.Enable(DEPTH_TEST)
+	gl.Viewport(1, 2, 3, 4)
+	gl.BlendFunc(SRC_ALPHA, ONE_MINUS_SRC_ALPHA)
+	gl.DepthFunc(LEQUAL)
+	bad := Snapshot(gl)
+	diffs := good.Diff(bad)
+	want := map[string]bool{
+		`Capabilities.GL_DEPTH_TEST: false -> true`:           true,
+		`Viewport[0]: 0 -> 1`:                                 true,
+		`Viewport[1]: 0 -> 2`:                                 true,
+		`Blend.SrcRGB: "GL_ONE" -> "GL_SRC_ALPHA"`:            true,
+		`Blend.DstRGB: "GL_ZERO" -> "GL_ONE_MINUS_SRC_ALPHA"`: true,
+		`Depth.Func: "GL_LESS" -> "GL_LEQUAL"`:                true,
+	}
+	for _, d := range diffs {
+		delete(want, d)
+	}
+	if len(want) != 0 {
+		t.Errorf("Missing differences %v in %v", want, diffs)
+	}
+}
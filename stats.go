@@ -0,0 +1,148 @@
+package gll
+
+import (
+	"fmt"
+	"io"
+	"math/bits"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// HistogramBuckets is the number of buckets in a CommandStats histogram
+const HistogramBuckets = 16
+
+// CommandStats holds call statistics for a single command
+type CommandStats struct {
+	Command CommandID
+	Calls   int
+	Time    time.Duration // Total wall time spent in the command
+	// Histogram counts calls by duration. Bucket i counts calls that took less than 2^i microseconds,
+	// but at least as long as the previous bucket. The last bucket counts all longer calls.
+	Histogram [HistogramBuckets]int
+}
+
+func (cs *CommandStats) add(d time.Duration) {
+	cs.Calls++
+	cs.Time += d
+	bucket := bits.Len64(uint64(d / time.Microsecond))
+	if bucket >= HistogramBuckets {
+		bucket = HistogramBuckets - 1
+	}
+	cs.Histogram[bucket]++
+}
+
+// StatsSnapshot is a copy of the statistics collected by a CallStats.
+// Command statistics are sorted by total time, most expensive first, and omit commands that were never called.
+type StatsSnapshot struct {
+	Frames    int            // The number of completed frames
+	Total     []CommandStats // Statistics for every call since the CallStats was created or reset
+	LastFrame []CommandStats // Statistics for the last completed frame
+}
+
+// CallStats is a GL that collects statistics about the commands it executes
+type CallStats struct {
+	GL460
+
+	frames int
+	total  []CommandStats
+	frame  []CommandStats
+	last   []CommandStats
+	start  time.Time
+}
+
+// Stats returns a CallStats that forwards every command to gl, counting and timing each call
+func Stats(gl GL460) *CallStats {
+	s := &CallStats{}
+	s.Reset()
+	s.GL460 = Hooked(gl, (*statsHooks)(s))
+	return s
+}
+
+// Reset discards all collected statistics
+func (s *CallStats) Reset() {
+	s.frames = 0
+	s.total = make([]CommandStats, len(commandInfo))
+	s.frame = make([]CommandStats, len(commandInfo))
+	s.last = nil
+	for i := range s.total {
+		s.total[i].Command = CommandID(i)
+		s.frame[i].Command = CommandID(i)
+	}
+}
+
+// Frame marks the end of a frame
+func (s *CallStats) Frame() {
+	s.frames++
+	s.last = s.frame
+	s.frame = make([]CommandStats, len(commandInfo))
+	for i := range s.frame {
+		s.frame[i].Command = CommandID(i)
+	}
+}
+
+// Snapshot returns a copy of the statistics collected so far
+func (s *CallStats) Snapshot() StatsSnapshot {
+	return StatsSnapshot{
+		Frames:    s.frames,
+		Total:     sortStats(s.total),
+		LastFrame: sortStats(s.last),
+	}
+}
+
+func sortStats(all []CommandStats) []CommandStats {
+	stats := []CommandStats{}
+	for _, cs := range all {
+		if cs.Calls > 0 {
+			stats = append(stats, cs)
+		}
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		return stats[i].Time > stats[j].Time
+	})
+	return stats
+}
+
+// WriteReport writes a report of the time spent in each command, in a format similar to pprof's text output
+func (s *CallStats) WriteReport(w io.Writer) error {
+	snap := s.Snapshot()
+	var total time.Duration
+	for _, cs := range snap.Total {
+		total += cs.Time
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 1, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "Frames: %d, total time: %v\n", snap.Frames, total)
+	fmt.Fprintf(tw, "flat\tflat%%\tsum%%\tcalls\tcalls/frame\tavg\t\n")
+	var sum time.Duration
+	for _, cs := range snap.Total {
+		sum += cs.Time
+		perFrame := "-"
+		if snap.Frames > 0 {
+			perFrame = fmt.Sprintf("%.1f", float64(cs.Calls)/float64(snap.Frames))
+		}
+		fmt.Fprintf(tw, "%v\t%.2f%%\t%.2f%%\t%d\t%s\t%v\t %s\n",
+			cs.Time, percent(cs.Time, total), percent(sum, total),
+			cs.Calls, perFrame, cs.Time/time.Duration(cs.Calls), cs.Command,
+		)
+	}
+	return tw.Flush()
+}
+
+func percent(d, total time.Duration) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(d) / float64(total)
+}
+
+type statsHooks CallStats
+
+func (h *statsHooks) Before(cmd CommandID, args []Arg) {
+	h.start = time.Now()
+}
+func (h *statsHooks) After(cmd CommandID, ret Arg) {
+	d := time.Since(h.start)
+	h.total[cmd].add(d)
+	h.frame[cmd].add(d)
+}
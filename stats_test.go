@@ -0,0 +1,56 @@
+package gll
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	gl := Stats(traceTestGL{})
+	for frame := 0; frame < 3; frame++ {
+		gl.Uniform1i(0, 0)
+		gl.Uniform1i(0, 0)
+		gl.Flush()
+		gl.Frame()
+	}
+	gl.Flush()
+
+	snap := gl.Snapshot()
+	if snap.Frames != 3 {
+		t.Errorf("Expected 3 frames, got %d", snap.Frames)
+	}
+	calls := make(map[CommandID]int)
+	for _, cs := range snap.Total {
+		calls[cs.Command] = cs.Calls
+		n := 0
+		for _, count := range cs.Histogram {
+			n += count
+		}
+		if n != cs.Calls {
+			t.Errorf("Histogram for %s has %d calls, expected %d", cs.Command, n, cs.Calls)
+		}
+	}
+	if len(calls) != 2 || calls[CmdUniform1i] != 6 || calls[CmdFlush] != 4 {
+		t.Errorf("Incorrect total call counts: %v", calls)
+	}
+	calls = make(map[CommandID]int)
+	for _, cs := range snap.LastFrame {
+		calls[cs.Command] = cs.Calls
+	}
+	if len(calls) != 2 || calls[CmdUniform1i] != 2 || calls[CmdFlush] != 1 {
+		t.Errorf("Incorrect last frame call counts: %v", calls)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := gl.WriteReport(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("glUniform1i")) {
+		t.Errorf("Report does not mention glUniform1i:\n%s", buf)
+	}
+
+	gl.Reset()
+	if snap := gl.Snapshot(); snap.Frames != 0 || len(snap.Total) != 0 || len(snap.LastFrame) != 0 {
+		t.Errorf("Statistics not reset: %v", snap)
+	}
+}
@@ -0,0 +1,163 @@
+package gll
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+// Tracer is a GL that writes a textual trace of every command it executes
+type Tracer struct {
+	GL460
+
+	// Prefixes restricts the trace to commands whose names start with one of the prefixes, eg. "glUniform".
+	// If empty, every command is traced.
+	Prefixes []string
+	// MaxElems is the number of elements printed for array arguments. Longer arrays are abbreviated.
+	// If zero, array arguments are printed as addresses.
+	MaxElems int
+
+	w     io.Writer
+	err   error
+	frame int
+	args  []Arg
+}
+
+// Trace returns a Tracer that forwards every command to gl and writes it to w, in the form
+//
+//	glBindBuffer(GL_ARRAY_BUFFER, 3)
+//	glIsEnabled(GL_DEPTH_TEST) = true
+func Trace(gl GL460, w io.Writer) *Tracer {
+	t := &Tracer{MaxElems: 8, w: w}
+	t.GL460 = Hooked(gl, (*traceHooks)(t))
+	return t
+}
+
+// Frame writes a frame marker to the trace
+func (t *Tracer) Frame() {
+	t.frame++
+	t.printf("# frame %d\n", t.frame)
+}
+
+// Err returns the first error encountered while writing the trace
+func (t *Tracer) Err() error {
+	return t.err
+}
+
+func (t *Tracer) printf(format string, args ...interface{}) {
+	if t.err == nil {
+		_, t.err = fmt.Fprintf(t.w, format, args...)
+	}
+}
+
+func (t *Tracer) traced(cmd CommandID) bool {
+	if len(t.Prefixes) == 0 {
+		return true
+	}
+	name := cmd.String()
+	for _, prefix := range t.Prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+type traceHooks Tracer
+
+func (h *traceHooks) Before(cmd CommandID, args []Arg) {
+	h.args = args
+}
+
+// The call is written after the command so that the contents of output arrays can be included
+func (h *traceHooks) After(cmd CommandID, ret Arg) {
+	t := (*Tracer)(h)
+	args := t.args
+	t.args = nil
+	if !t.traced(cmd) {
+		return
+	}
+
+	info := cmd.Info()
+	argS := make([]string, len(args))
+	for i := range args {
+		argS[i] = t.formatArg(info, args, i)
+	}
+	if info.Return == "" {
+		t.printf("%s(%s)\n", info.Name, strings.Join(argS, ", "))
+		return
+	}
+	t.printf("%s(%s) = %s\n", info.Name, strings.Join(argS, ", "), formatReturn(info, ret))
+}
+
+func formatReturn(info *CommandInfo, ret Arg) string {
+	if v, ok := ret.(uint32); ok {
+		if name := enumString(info.ReturnGroup, v); name != "" {
+			return name
+		}
+	}
+	return fmt.Sprint(ret)
+}
+
+func (t *Tracer) formatArg(info *CommandInfo, args []Arg, i int) string {
+	par := info.Params[i]
+	switch arg := args[i].(type) {
+	case uint32:
+		if name := enumString(par.Group, arg); name != "" {
+			return name
+		}
+	case unsafe.Pointer:
+		if arg == nil {
+			return "NULL"
+		}
+		return fmt.Sprintf("%p", arg)
+	}
+
+	v := reflect.ValueOf(args[i])
+	if v.Kind() != reflect.Ptr {
+		return fmt.Sprint(args[i])
+	}
+	if v.IsNil() {
+		return "NULL"
+	}
+	if par.CString() {
+		return fmt.Sprintf("%q", GoStr(args[i].(*uint8)))
+	}
+	n, ok := info.ArgLen(args, i)
+	if !ok || t.MaxElems == 0 || v.Type().Elem().Kind() == reflect.Ptr {
+		return fmt.Sprintf("%p", args[i])
+	}
+
+	elems := make([]string, 0, n)
+	arr := reflect.NewAt(reflect.ArrayOf(n, v.Type().Elem()), unsafe.Pointer(v.Pointer())).Elem()
+	for j := 0; j < n; j++ {
+		if j == t.MaxElems {
+			elems = append(elems, fmt.Sprintf("... (%d elements)", n))
+			break
+		}
+		elems = append(elems, fmt.Sprint(arr.Index(j)))
+	}
+	return "[" + strings.Join(elems, " ") + "]"
+}
+
+// enumString returns the registry name of value within an enum group, or "" if the group has no such member.
+// Values in bitmask groups are written as the names of their bits separated by |, eg. GL_COLOR_BUFFER_BIT|GL_DEPTH_BUFFER_BIT.
+func enumString(group string, value uint32) string {
+	if name := EnumName(group, value); name != "" || !bitmaskGroups[group] || value == 0 {
+		return name
+	}
+	var names []string
+	for bit := uint32(1); bit != 0; bit <<= 1 {
+		if value&bit == 0 {
+			continue
+		}
+		name := EnumName(group, bit)
+		if name == "" {
+			return ""
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, "|")
+}
@@ -0,0 +1,85 @@
+package gll
+
+import (
+	"bytes"
+	"testing"
+	"unsafe"
+)
+
+type traceTestGL struct {
+	GL460
+}
+
+func (traceTestGL) Flush()                       {}
+func (traceTestGL) Uniform1i(location, v0 int32) {}
+func (traceTestGL) IsBuffer(buffer Buffer) bool {
+	return buffer == 3
+}
+func (traceTestGL) BindBuffer(target uint32, buffer Buffer)                               {}
+func (traceTestGL) BufferData(target uint32, size int, data unsafe.Pointer, usage uint32) {}
+func (traceTestGL) DeleteBuffers(n int32, buffers *Buffer)                                {}
+func (traceTestGL) Uniform4fv(location, count int32, value *float32)                      {}
+func (traceTestGL) Clear(mask uint32)                                                     {}
+func (traceTestGL) GetUniformLocation(program Program, name *uint8) int32 {
+	return -1
+}
+
+func TestTrace(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gl := Trace(traceTestGL{}, buf)
+	gl.Uniform1i(2, 5)
+	gl.IsBuffer(3)
+	gl.Frame()
+	gl.Flush()
+
+	gl.Prefixes = []string{"glIs", "glFlush"}
+	gl.Uniform1i(2, 6)
+	gl.IsBuffer(4)
+
+	expected := "glUniform1i(2, 5)\n" +
+		"glIsBuffer(3) = true\n" +
+		"# frame 1\n" +
+		"glFlush()\n" +
+		"glIsBuffer(4) = false\n"
+	if buf.String() != expected {
+		t.Errorf("Incorrect trace:\n%s\nExpected:\n%s", buf, expected)
+	}
+}
+
+func TestTraceArgs(t *testing.T) {
+	buf := &bytes.Buffer{}
+	gl := Trace(traceTestGL{}, buf)
+	gl.BindBuffer(ARRAY_BUFFER, 3)
+	gl.BufferData(ARRAY_BUFFER, 16, nil, STATIC_DRAW)
+	buffers := []Buffer{3, 4}
+	gl.DeleteBuffers(int32(len(buffers)), &buffers[0])
+	values := make([]float32, 12)
+	for i := range values {
+		values[i] = float32(i + 1)
+	}
+	gl.Uniform4fv(1, 3, &values[0])
+	gl.GetUniformLocation(1, Str("offset\x00"))
+	gl.Clear(COLOR_BUFFER_BIT | DEPTH_BUFFER_BIT)
+	gl.Clear(COLOR_BUFFER_BIT | 0x1)
+
+	expected := "glBindBuffer(GL_ARRAY_BUFFER, 3)\n" +
+		"glBufferData(GL_ARRAY_BUFFER, 16, NULL, GL_STATIC_DRAW)\n" +
+		"glDeleteBuffers(2, [3 4])\n" +
+		"glUniform4fv(1, 3, [1 2 3 4 5 6 7 8 ... (12 elements)])\n" +
+		"glGetUniformLocation(1, \"offset\") = -1\n" +
+		"glClear(GL_DEPTH_BUFFER_BIT|GL_COLOR_BUFFER_BIT)\n" +
+		"glClear(16385)\n"
+	if buf.String() != expected {
+		t.Errorf("Incorrect trace:\n%s\nExpected:\n%s", buf, expected)
+	}
+}
+
+func TestTraceReturn(t *testing.T) {
+	info := &CommandInfo{Name: "glGetError", Return: "uint32", ReturnGroup: "ErrorCode"}
+	if s := formatReturn(info, uint32(INVALID_OPERATION)); s != "GL_INVALID_OPERATION" {
+		t.Errorf("Incorrect return value: %s", s)
+	}
+	if s := formatReturn(info, uint32(0x1234)); s != "4660" {
+		t.Errorf("Incorrect unknown return value: %s", s)
+	}
+}
@@ -0,0 +1,585 @@
+package gll
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// ValidationError describes an invalid use of the GL detected by a Validator
+type ValidationError struct {
+	Command CommandID
+	Message string
+
+	// Caller is the Go call site of the command
+	Caller runtime.Frame
+	// Stack contains the program counters of the Go stack, starting at Caller.
+	// Frames belonging to gll and cgo are omitted.
+	Stack []uintptr
+}
+
+func (err *ValidationError) Error() string {
+	if err.Caller.File == "" {
+		return fmt.Sprintf("%s: %s", err.Command, err.Message)
+	}
+	return fmt.Sprintf("%s:%d: %s: %s", err.Caller.File, err.Caller.Line, err.Command, err.Message)
+}
+
+// Validator is a GL that shadows object lifetimes and bindings in order to detect common mistakes before they reach the driver.
+// It reports:
+//   - use of deleted objects
+//   - commands that operate on the object bound to a target when no object is bound
+//   - draws without a program, or without a vertex array object in core profile contexts
+//   - mapping a buffer that is already mapped, or unmapping one that is not
+//
+// The Validator only knows about state changed through it, so it should wrap gl before any objects are created or bound.
+// Objects passed to commands other than the ones that create, delete and bind them are only checked if the registry gl.go
+// was generated from gives the class of the parameter.
+type Validator struct {
+	GL460
+
+	// Handler is called with every error found. If nil, the Validator panics with the error instead.
+	Handler func(err *ValidationError)
+	// CoreProfile is true if the context uses the core profile, in which drawing requires a vertex array object
+	CoreProfile bool
+
+	args []Arg
+
+	objects     map[string]map[uint32]bool // Known object names, mapped to false once deleted
+	mapped      map[uint32]bool            // Mapped buffers
+	buffers     map[uint32]uint32          // Buffer bindings by target, except ELEMENT_ARRAY_BUFFER
+	elements    map[uint32]uint32          // ELEMENT_ARRAY_BUFFER bindings by vertex array
+	textures    map[uint32]map[uint32]uint32
+	texTargets  map[uint32]uint32 // Texture targets, known once a texture is bound or created
+	unit        uint32
+	drawFB      uint32
+	readFB      uint32
+	renderbuf   uint32
+	vertexArray uint32
+	program     uint32
+	pipeline    uint32
+}
+
+// Validate returns a Validator that checks every command before forwarding it to gl
+func Validate(gl GL460) *Validator {
+	v := &Validator{
+		objects:    make(map[string]map[uint32]bool),
+		mapped:     make(map[uint32]bool),
+		buffers:    make(map[uint32]uint32),
+		elements:   make(map[uint32]uint32),
+		textures:   make(map[uint32]map[uint32]uint32),
+		texTargets: make(map[uint32]uint32),
+	}
+	var major, minor int
+	fmt.Sscanf(GoStr(gl.GetString(VERSION)), "%d.%d", &major, &minor)
+	if major > 3 || major == 3 && minor >= 2 {
+		var mask int32
+		gl.GetIntegerv(CONTEXT_PROFILE_MASK, &mask)
+		v.CoreProfile = mask&CONTEXT_CORE_PROFILE_BIT != 0
+	}
+	v.GL460 = Hooked(gl, (*validateHooks)(v))
+	return v
+}
+
+func (v *Validator) report(cmd CommandID, format string, args ...interface{}) {
+	err := &ValidationError{Command: cmd, Message: fmt.Sprintf(format, args...)}
+	err.Caller, err.Stack = debugCaller()
+	if v.Handler == nil {
+		panic(err)
+	}
+	v.Handler(err)
+}
+
+func (v *Validator) create(class string, name uint32) {
+	if v.objects[class] == nil {
+		v.objects[class] = make(map[uint32]bool)
+	}
+	v.objects[class][name] = true
+}
+
+// use reports an error if name is a deleted object
+func (v *Validator) use(cmd CommandID, class string, name uint32) {
+	if alive, ok := v.objects[class][name]; ok && !alive {
+		v.report(cmd, "use of deleted %s %d", class, name)
+	}
+}
+
+// useArg checks the object passed as argument i and returns its name.
+// Parameters with a class in the registry have already been checked.
+func (v *Validator) useArg(cmd CommandID, class string, args []Arg, i int) uint32 {
+	name, _ := objectName(args[i])
+	if cmd.Info().Params[i].Class == "" {
+		v.use(cmd, class, name)
+	}
+	return name
+}
+
+func (v *Validator) delete(cmd CommandID, class string, name uint32) {
+	if name == 0 {
+		return
+	}
+	v.use(cmd, class, name)
+	if _, ok := v.objects[class][name]; ok {
+		v.objects[class][name] = false
+	}
+
+	// Deleting a bound object unbinds it
+	switch class {
+	case "buffer":
+		delete(v.mapped, name)
+		for target, bound := range v.buffers {
+			if bound == name {
+				v.buffers[target] = 0
+			}
+		}
+		if v.elements[v.vertexArray] == name {
+			v.elements[v.vertexArray] = 0
+		}
+	case "texture":
+		delete(v.texTargets, name)
+		for _, unit := range v.textures {
+			for target, bound := range unit {
+				if bound == name {
+					unit[target] = 0
+				}
+			}
+		}
+	case "framebuffer":
+		if v.drawFB == name {
+			v.drawFB = 0
+		}
+		if v.readFB == name {
+			v.readFB = 0
+		}
+	case "renderbuffer":
+		if v.renderbuf == name {
+			v.renderbuf = 0
+		}
+	case "vertex array":
+		if v.vertexArray == name {
+			v.vertexArray = 0
+		}
+		delete(v.elements, name)
+	case "program pipeline":
+		if v.pipeline == name {
+			v.pipeline = 0
+		}
+	}
+	// A deleted program stays in use until another one replaces it
+}
+
+func (v *Validator) bindBuffer(target, buffer uint32) {
+	if target == ELEMENT_ARRAY_BUFFER {
+		v.elements[v.vertexArray] = buffer
+	} else {
+		v.buffers[target] = buffer
+	}
+}
+
+func (v *Validator) boundBuffer(target uint32) uint32 {
+	if target == ELEMENT_ARRAY_BUFFER {
+		return v.elements[v.vertexArray]
+	}
+	return v.buffers[target]
+}
+
+func (v *Validator) bindTexture(unit, target, texture uint32) {
+	if v.textures[unit] == nil {
+		v.textures[unit] = make(map[uint32]uint32)
+	}
+	v.textures[unit][target] = texture
+}
+
+// bindTextureUnit binds a texture to the target it was created for, as BindTextureUnit does.
+// Zero unbinds every target of the unit.
+func (v *Validator) bindTextureUnit(unit, texture uint32) {
+	if texture == 0 {
+		delete(v.textures, unit)
+	} else if target, ok := v.texTargets[texture]; ok {
+		v.bindTexture(unit, target, texture)
+	}
+}
+
+// useArray checks the array of objects passed as argument i, and returns their names
+func (v *Validator) useArray(cmd CommandID, class string, args []Arg, i int) []uint32 {
+	n, ok := cmd.Info().ArgLen(args, i)
+	names, _ := objectNames(args[i])
+	if !ok || n <= 0 || names == nil {
+		return nil
+	}
+	namesS := *(*[]uint32)(mkslice(uintptr(unsafe.Pointer(names)), n))
+	for _, name := range namesS {
+		v.use(cmd, class, name)
+	}
+	return namesS
+}
+
+func (v *Validator) boundFramebuffer(target uint32) uint32 {
+	if target == READ_FRAMEBUFFER {
+		return v.readFB
+	}
+	return v.drawFB
+}
+
+// checkBound reports an error if no object is bound to the target passed as argument i
+func (v *Validator) checkBound(cmd CommandID, class string, args []Arg, i int) {
+	target := args[i].(uint32)
+	var bound uint32
+	switch class {
+	case "buffer":
+		bound = v.boundBuffer(target)
+	case "texture":
+		if proxyTextures[target] {
+			return
+		}
+		if target >= TEXTURE_CUBE_MAP_POSITIVE_X && target <= TEXTURE_CUBE_MAP_NEGATIVE_Z {
+			target = TEXTURE_CUBE_MAP
+		}
+		bound = v.textures[v.unit][target]
+	case "framebuffer":
+		bound = v.boundFramebuffer(target)
+	case "renderbuffer":
+		bound = v.renderbuf
+	}
+	if bound == 0 {
+		v.report(cmd, "no %s bound to %s", class, v.enumString(cmd, i, target))
+	}
+}
+
+func (v *Validator) enumString(cmd CommandID, i int, value uint32) string {
+	if name := EnumName(cmd.Info().Params[i].Group, value); name != "" {
+		return name
+	}
+	return fmt.Sprintf("%#x", value)
+}
+
+func (v *Validator) checkDraw(cmd CommandID) {
+	if v.program == 0 && v.pipeline == 0 {
+		v.report(cmd, "no program in use")
+	}
+	if v.CoreProfile && v.vertexArray == 0 {
+		v.report(cmd, "no vertex array object bound")
+	}
+	if elementDraws[cmd] && v.CoreProfile && v.elements[v.vertexArray] == 0 {
+		v.report(cmd, "no buffer bound to GL_ELEMENT_ARRAY_BUFFER")
+	}
+	if indirectDraws[cmd] && v.CoreProfile && v.buffers[DRAW_INDIRECT_BUFFER] == 0 {
+		v.report(cmd, "no buffer bound to GL_DRAW_INDIRECT_BUFFER")
+	}
+}
+
+func (v *Validator) checkMap(cmd CommandID, buffer uint32) {
+	if buffer != 0 && v.mapped[buffer] {
+		v.report(cmd, "buffer %d is already mapped", buffer)
+	}
+}
+
+// mapBuffer records a buffer as mapped once the map command has succeeded
+func (v *Validator) mapBuffer(buffer uint32, ptr Arg) {
+	if buffer != 0 && ptr.(unsafe.Pointer) != nil {
+		v.mapped[buffer] = true
+	}
+}
+
+func (v *Validator) unmapBuffer(cmd CommandID, buffer uint32) {
+	if buffer == 0 {
+		return
+	}
+	if !v.mapped[buffer] {
+		v.report(cmd, "buffer %d is not mapped", buffer)
+	}
+	delete(v.mapped, buffer)
+}
+
+type validateHooks Validator
+
+func (h *validateHooks) Before(cmd CommandID, args []Arg) {
+	v := (*Validator)(h)
+	v.args = args
+	if class, ok := deleteCommands[cmd]; ok {
+		if name, ok := objectName(args[0]); ok && len(args) == 1 {
+			v.delete(cmd, class, name)
+		} else {
+			for _, name := range nameArray(args) {
+				v.delete(cmd, class, name)
+			}
+		}
+		return
+	}
+
+	info := cmd.Info()
+	for i, par := range info.Params {
+		if name, ok := objectName(args[i]); ok && par.Class != "" {
+			v.use(cmd, par.Class, name)
+		}
+	}
+	if class, ok := targetCommands[cmd]; ok {
+		for i, par := range info.Params {
+			if targetParams[par.Name] {
+				v.checkBound(cmd, class, args, i)
+			}
+		}
+	}
+	if elementDraws[cmd] || arrayDraws[cmd] {
+		v.checkDraw(cmd)
+	}
+
+	switch cmd {
+	case CmdBindBuffer:
+		v.bindBuffer(args[0].(uint32), v.useArg(cmd, "buffer", args, 1))
+	case CmdBindBufferBase, CmdBindBufferRange:
+		// Binding to an indexed target also binds to the generic target
+		v.bindBuffer(args[0].(uint32), v.useArg(cmd, "buffer", args, 2))
+	case CmdBindBuffersBase, CmdBindBuffersRange:
+		// Unlike BindBufferBase, these leave the generic target unchanged
+		v.useArray(cmd, "buffer", args, 3)
+	case CmdVertexArrayElementBuffer:
+		vao, _ := objectName(args[0])
+		v.elements[vao] = v.useArg(cmd, "buffer", args, 1)
+	case CmdActiveTexture:
+		v.unit = args[0].(uint32) - TEXTURE0
+	case CmdBindTexture:
+		target, texture := args[0].(uint32), v.useArg(cmd, "texture", args, 1)
+		v.bindTexture(v.unit, target, texture)
+		if texture != 0 {
+			v.texTargets[texture] = target
+		}
+	case CmdBindTextureUnit:
+		v.bindTextureUnit(args[0].(uint32), v.useArg(cmd, "texture", args, 1))
+	case CmdBindTextures:
+		first, count := args[0].(uint32), args[1].(int32)
+		textures := v.useArray(cmd, "texture", args, 2)
+		for i := uint32(0); i < uint32(count); i++ {
+			// A null array unbinds every unit in the range
+			var texture uint32
+			if textures != nil {
+				texture = textures[i]
+			}
+			v.bindTextureUnit(first+i, texture)
+		}
+	case CmdBindFramebuffer:
+		fb := v.useArg(cmd, "framebuffer", args, 1)
+		switch args[0].(uint32) {
+		case FRAMEBUFFER:
+			v.drawFB, v.readFB = fb, fb
+		case DRAW_FRAMEBUFFER:
+			v.drawFB = fb
+		case READ_FRAMEBUFFER:
+			v.readFB = fb
+		}
+	case CmdBindRenderbuffer:
+		v.renderbuf = v.useArg(cmd, "renderbuffer", args, 1)
+	case CmdBindVertexArray:
+		v.vertexArray = v.useArg(cmd, "vertex array", args, 0)
+	case CmdUseProgram:
+		v.program = v.useArg(cmd, "program", args, 0)
+	case CmdBindProgramPipeline:
+		v.pipeline = v.useArg(cmd, "program pipeline", args, 0)
+	case CmdBindSampler:
+		v.useArg(cmd, "sampler", args, 1)
+	case CmdBindTransformFeedback:
+		v.useArg(cmd, "transform feedback", args, 1)
+
+	case CmdMapBuffer, CmdMapBufferRange:
+		v.checkMap(cmd, v.boundBuffer(args[0].(uint32)))
+	case CmdMapNamedBuffer, CmdMapNamedBufferRange:
+		v.checkMap(cmd, v.useArg(cmd, "buffer", args, 0))
+	case CmdUnmapBuffer:
+		v.unmapBuffer(cmd, v.boundBuffer(args[0].(uint32)))
+	case CmdUnmapNamedBuffer:
+		v.unmapBuffer(cmd, v.useArg(cmd, "buffer", args, 0))
+	}
+}
+
+func (h *validateHooks) After(cmd CommandID, ret Arg) {
+	v := (*Validator)(h)
+	args := v.args
+	v.args = nil
+	if class, ok := createCommands[cmd]; ok {
+		if name, ok := objectName(ret); ok {
+			v.create(class, name)
+		} else {
+			for _, name := range nameArray(args) {
+				v.create(class, name)
+				if cmd == CmdCreateTextures {
+					v.texTargets[name] = args[0].(uint32)
+				}
+			}
+		}
+	}
+
+	switch cmd {
+	case CmdMapBuffer, CmdMapBufferRange:
+		v.mapBuffer(v.boundBuffer(args[0].(uint32)), ret)
+	case CmdMapNamedBuffer, CmdMapNamedBufferRange:
+		buffer, _ := objectName(args[0])
+		v.mapBuffer(buffer, ret)
+	}
+}
+
+// nameArray returns the array of object names passed to a command that creates or deletes several objects.
+// The last two parameters of such commands are the number of objects and the array.
+func nameArray(args []Arg) []uint32 {
+	n := int(args[len(args)-2].(int32))
+	names, _ := objectNames(args[len(args)-1])
+	if n <= 0 || names == nil {
+		return nil
+	}
+	return *(*[]uint32)(mkslice(uintptr(unsafe.Pointer(names)), n))
+}
+
+var createCommands = map[CommandID]string{
+	CmdGenBuffers:               "buffer",
+	CmdCreateBuffers:            "buffer",
+	CmdGenTextures:              "texture",
+	CmdCreateTextures:           "texture",
+	CmdGenFramebuffers:          "framebuffer",
+	CmdCreateFramebuffers:       "framebuffer",
+	CmdGenRenderbuffers:         "renderbuffer",
+	CmdCreateRenderbuffers:      "renderbuffer",
+	CmdGenVertexArrays:          "vertex array",
+	CmdCreateVertexArrays:       "vertex array",
+	CmdGenSamplers:              "sampler",
+	CmdCreateSamplers:           "sampler",
+	CmdGenQueries:               "query",
+	CmdCreateQueries:            "query",
+	CmdGenTransformFeedbacks:    "transform feedback",
+	CmdCreateTransformFeedbacks: "transform feedback",
+	CmdGenProgramPipelines:      "program pipeline",
+	CmdCreateProgramPipelines:   "program pipeline",
+	CmdCreateProgram:            "program",
+	CmdCreateShader:             "shader",
+}
+
+var deleteCommands = map[CommandID]string{
+	CmdDeleteBuffers:            "buffer",
+	CmdDeleteTextures:           "texture",
+	CmdDeleteFramebuffers:       "framebuffer",
+	CmdDeleteRenderbuffers:      "renderbuffer",
+	CmdDeleteVertexArrays:       "vertex array",
+	CmdDeleteSamplers:           "sampler",
+	CmdDeleteQueries:            "query",
+	CmdDeleteTransformFeedbacks: "transform feedback",
+	CmdDeleteProgramPipelines:   "program pipeline",
+	CmdDeleteProgram:            "program",
+	CmdDeleteShader:             "shader",
+}
+
+// targetCommands operate on the object of the given class bound to their target parameters
+var targetCommands = map[CommandID]string{
+	CmdBufferData:                     "buffer",
+	CmdBufferSubData:                  "buffer",
+	CmdBufferStorage:                  "buffer",
+	CmdGetBufferSubData:               "buffer",
+	CmdMapBuffer:                      "buffer",
+	CmdMapBufferRange:                 "buffer",
+	CmdUnmapBuffer:                    "buffer",
+	CmdFlushMappedBufferRange:         "buffer",
+	CmdGetBufferParameteriv:           "buffer",
+	CmdGetBufferParameteri64v:         "buffer",
+	CmdGetBufferPointerv:              "buffer",
+	CmdClearBufferData:                "buffer",
+	CmdClearBufferSubData:             "buffer",
+	CmdCopyBufferSubData:              "buffer",
+	CmdTexImage1D:                     "texture",
+	CmdTexImage2D:                     "texture",
+	CmdTexImage3D:                     "texture",
+	CmdTexImage2DMultisample:          "texture",
+	CmdTexImage3DMultisample:          "texture",
+	CmdTexSubImage1D:                  "texture",
+	CmdTexSubImage2D:                  "texture",
+	CmdTexSubImage3D:                  "texture",
+	CmdTexStorage1D:                   "texture",
+	CmdTexStorage2D:                   "texture",
+	CmdTexStorage3D:                   "texture",
+	CmdTexStorage2DMultisample:        "texture",
+	CmdTexStorage3DMultisample:        "texture",
+	CmdCompressedTexImage1D:           "texture",
+	CmdCompressedTexImage2D:           "texture",
+	CmdCompressedTexImage3D:           "texture",
+	CmdCompressedTexSubImage1D:        "texture",
+	CmdCompressedTexSubImage2D:        "texture",
+	CmdCompressedTexSubImage3D:        "texture",
+	CmdCopyTexImage1D:                 "texture",
+	CmdCopyTexImage2D:                 "texture",
+	CmdCopyTexSubImage1D:              "texture",
+	CmdCopyTexSubImage2D:              "texture",
+	CmdCopyTexSubImage3D:              "texture",
+	CmdTexParameterf:                  "texture",
+	CmdTexParameterfv:                 "texture",
+	CmdTexParameteri:                  "texture",
+	CmdTexParameteriv:                 "texture",
+	CmdTexParameterIiv:                "texture",
+	CmdTexParameterIuiv:               "texture",
+	CmdTexBuffer:                      "texture",
+	CmdTexBufferRange:                 "texture",
+	CmdGenerateMipmap:                 "texture",
+	CmdRenderbufferStorage:            "renderbuffer",
+	CmdRenderbufferStorageMultisample: "renderbuffer",
+	CmdGetRenderbufferParameteriv:     "renderbuffer",
+	CmdFramebufferTexture:             "framebuffer",
+	CmdFramebufferTexture1D:           "framebuffer",
+	CmdFramebufferTexture2D:           "framebuffer",
+	CmdFramebufferTexture3D:           "framebuffer",
+	CmdFramebufferTextureLayer:        "framebuffer",
+	CmdFramebufferRenderbuffer:        "framebuffer",
+	CmdFramebufferParameteri:          "framebuffer",
+}
+
+var targetParams = map[string]bool{
+	"target":      true,
+	"readTarget":  true,
+	"writeTarget": true,
+}
+
+var proxyTextures = map[uint32]bool{
+	PROXY_TEXTURE_1D:                   true,
+	PROXY_TEXTURE_2D:                   true,
+	PROXY_TEXTURE_3D:                   true,
+	PROXY_TEXTURE_1D_ARRAY:             true,
+	PROXY_TEXTURE_2D_ARRAY:             true,
+	PROXY_TEXTURE_RECTANGLE:            true,
+	PROXY_TEXTURE_CUBE_MAP:             true,
+	PROXY_TEXTURE_CUBE_MAP_ARRAY:       true,
+	PROXY_TEXTURE_2D_MULTISAMPLE:       true,
+	PROXY_TEXTURE_2D_MULTISAMPLE_ARRAY: true,
+}
+
+var arrayDraws = map[CommandID]bool{
+	CmdDrawArrays:                           true,
+	CmdDrawArraysInstanced:                  true,
+	CmdDrawArraysInstancedBaseInstance:      true,
+	CmdDrawArraysIndirect:                   true,
+	CmdMultiDrawArrays:                      true,
+	CmdMultiDrawArraysIndirect:              true,
+	CmdMultiDrawArraysIndirectCount:         true,
+	CmdDrawTransformFeedback:                true,
+	CmdDrawTransformFeedbackInstanced:       true,
+	CmdDrawTransformFeedbackStream:          true,
+	CmdDrawTransformFeedbackStreamInstanced: true,
+}
+
+var elementDraws = map[CommandID]bool{
+	CmdDrawElements:                                true,
+	CmdDrawElementsBaseVertex:                      true,
+	CmdDrawElementsInstanced:                       true,
+	CmdDrawElementsInstancedBaseVertex:             true,
+	CmdDrawElementsInstancedBaseInstance:           true,
+	CmdDrawElementsInstancedBaseVertexBaseInstance: true,
+	CmdDrawRangeElements:                           true,
+	CmdDrawRangeElementsBaseVertex:                 true,
+	CmdDrawElementsIndirect:                        true,
+	CmdMultiDrawElements:                           true,
+	CmdMultiDrawElementsBaseVertex:                 true,
+	CmdMultiDrawElementsIndirect:                   true,
+	CmdMultiDrawElementsIndirectCount:              true,
+}
+
+var indirectDraws = map[CommandID]bool{
+	CmdDrawArraysIndirect:             true,
+	CmdMultiDrawArraysIndirect:        true,
+	CmdMultiDrawArraysIndirectCount:   true,
+	CmdDrawElementsIndirect:           true,
+	CmdMultiDrawElementsIndirect:      true,
+	CmdMultiDrawElementsIndirectCount: true,
+}
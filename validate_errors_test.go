@@ -0,0 +1,155 @@
+//go:build !gll_checkerrors
+// +build !gll_checkerrors
+
+// These tests issue the invalid commands that the Validator reports, which panic when errors are checked
+
+package gll
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vktec/gll/internal/headless"
+)
+
+func TestValidate(t *testing.T) {
+	ctx, err := headless.New(4, 5)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ctx.Destroy()
+
+	var errs []*ValidationError
+	gl := Validate(New460(ctx.GetProcAddress))
+	gl.Handler = func(err *ValidationError) {
+		errs = append(errs, err)
+	}
+	if !gl.CoreProfile {
+		t.Error("Core profile context not detected")
+	}
+	expect := func(cmd CommandID, message string) {
+		t.Helper()
+		if len(errs) != 1 {
+			t.Fatalf("Expected 1 error, got %v", errs)
+		}
+		err := errs[0]
+		errs = nil
+		if err.Command != cmd || err.Message != message {
+			t.Errorf("Expected %s: %s, got %s: %s", cmd, message, err.Command, err.Message)
+		}
+	}
+	expectNone := func() {
+		t.Helper()
+		if len(errs) != 0 {
+			t.Fatalf("Unexpected errors: %v", errs)
+		}
+	}
+
+	gl.BufferData(ARRAY_BUFFER, 4, nil, STATIC_DRAW)
+	expect(CmdBufferData, "no buffer bound to GL_ARRAY_BUFFER")
+
+	var buf Buffer
+	gl.GenBuffers(1, &buf)
+	gl.BindBuffer(ARRAY_BUFFER, buf)
+	gl.BufferData(ARRAY_BUFFER, 4, nil, STATIC_DRAW)
+	expectNone()
+
+	gl.MapBuffer(ARRAY_BUFFER, WRITE_ONLY)
+	gl.MapNamedBuffer(buf, WRITE_ONLY)
+	expect(CmdMapNamedBuffer, fmt.Sprintf("buffer %d is already mapped", buf))
+	gl.UnmapBuffer(ARRAY_BUFFER)
+	gl.UnmapBuffer(ARRAY_BUFFER)
+	expect(CmdUnmapBuffer, fmt.Sprintf("buffer %d is not mapped", buf))
+
+	gl.DeleteBuffers(1, &buf)
+	expectNone()
+	gl.BufferData(ARRAY_BUFFER, 4, nil, STATIC_DRAW)
+	expect(CmdBufferData, "no buffer bound to GL_ARRAY_BUFFER")
+	gl.BindBuffer(ARRAY_BUFFER, buf)
+	expect(CmdBindBuffer, fmt.Sprintf("use of deleted buffer %d", buf))
+	gl.DeleteBuffers(1, &buf)
+	expect(CmdDeleteBuffers, fmt.Sprintf("use of deleted buffer %d", buf))
+
+	// Reused names are valid again
+	gl.GenBuffers(1, &buf)
+	gl.BindBuffer(ARRAY_BUFFER, buf)
+	expectNone()
+
+	gl.DrawArrays(TRIANGLES, 0, 3)
+	if len(errs) != 2 || errs[0].Message != "no program in use" || errs[1].Message != "no vertex array object bound" {
+		t.Errorf("Unexpected errors for draw: %v", errs)
+	}
+	errs = nil
+
+	prog := gl.CreateProgram()
+	gl.UseProgram(prog)
+	var vao VertexArray
+	gl.GenVertexArrays(1, &vao)
+	gl.BindVertexArray(vao)
+	gl.DrawElements(TRIANGLES, 3, UNSIGNED_SHORT, nil)
+	expect(CmdDrawElements, "no buffer bound to GL_ELEMENT_ARRAY_BUFFER")
+
+	gl.DeleteProgram(prog)
+	gl.UseProgram(0)
+	gl.UseProgram(prog)
+	expect(CmdUseProgram, fmt.Sprintf("use of deleted program %d", prog))
+}
+
+func TestValidateDSA(t *testing.T) {
+	ctx, err := headless.New(4, 5)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ctx.Destroy()
+
+	var errs []*ValidationError
+	gl := Validate(New460(ctx.GetProcAddress))
+	gl.Handler = func(err *ValidationError) {
+		errs = append(errs, err)
+	}
+	expect := func(message string) {
+		t.Helper()
+		if message == "" && len(errs) == 0 || len(errs) == 1 && errs[0].Message == message {
+			errs = nil
+			return
+		}
+		t.Fatalf("Expected %q, got %v", message, errs)
+	}
+
+	var buf Buffer
+	gl.CreateBuffers(1, &buf)
+	gl.NamedBufferData(buf, 4, nil, STATIC_DRAW)
+	var vao VertexArray
+	gl.CreateVertexArrays(1, &vao)
+	gl.VertexArrayElementBuffer(vao, buf)
+	gl.BindVertexArray(vao)
+	gl.UseProgram(gl.CreateProgram())
+	gl.DrawElements(TRIANGLES, 3, UNSIGNED_SHORT, nil)
+	expect("")
+
+	var tex Texture
+	gl.CreateTextures(TEXTURE_2D, 1, &tex)
+	gl.BindTextureUnit(0, tex)
+	gl.TexParameteri(TEXTURE_2D, TEXTURE_MIN_FILTER, LINEAR)
+	expect("")
+	gl.BindTextures(0, 1, nil)
+	gl.TexParameteri(TEXTURE_2D, TEXTURE_MIN_FILTER, LINEAR)
+	expect("no texture bound to GL_TEXTURE_2D")
+	gl.BindTextures(0, 1, &tex)
+	gl.TexParameteri(TEXTURE_2D, TEXTURE_MIN_FILTER, LINEAR)
+	expect("")
+
+	// BindBuffersBase leaves the generic binding unchanged
+	gl.BindBuffersBase(UNIFORM_BUFFER, 0, 1, &buf)
+	gl.BufferData(UNIFORM_BUFFER, 4, nil, STATIC_DRAW)
+	expect("no buffer bound to GL_UNIFORM_BUFFER")
+
+	// A failed map leaves the buffer unmapped
+	if gl.MapNamedBufferRange(buf, 0, 4, 0) != nil {
+		t.Fatal("Map with no access bits succeeded")
+	}
+	gl.MapNamedBuffer(buf, WRITE_ONLY)
+	expect("")
+	gl.UnmapNamedBuffer(buf)
+	expect("")
+}
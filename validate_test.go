@@ -0,0 +1,28 @@
+package gll
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vktec/gll/internal/headless"
+)
+
+func TestValidateClass(t *testing.T) {
+	ctx, err := headless.New(4, 5)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ctx.Destroy()
+
+	gl := Validate(New460(ctx.GetProcAddress))
+	var buf Buffer
+	gl.CreateBuffers(1, &buf)
+	gl.DeleteBuffers(1, &buf)
+	defer func() {
+		err, _ := recover().(*ValidationError)
+		if expected := fmt.Sprintf("use of deleted buffer %d", buf); err == nil || err.Message != expected {
+			t.Errorf("Expected use of deleted buffer, got %v", err)
+		}
+	}()
+	gl.NamedBufferData(buf, 4, nil, STATIC_DRAW)
+}